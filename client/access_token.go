@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
+)
+
+// AccessToken is a short-lived meshStack access token, as obtained by the provider itself when it
+// is configured with an API key.
+type AccessToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type AccessTokenClient interface {
+	// Login exchanges the client ID and secret of an API key for a new access token. Unlike the
+	// provider's own authorization, the token is neither cached nor renewed.
+	Login(ctx context.Context, clientId, clientSecret string) (*AccessToken, error)
+}
+
+type accessTokenClient struct {
+	httpClient internal.HttpClient
+}
+
+func newAccessTokenClient(httpClient internal.HttpClient) AccessTokenClient {
+	return accessTokenClient{httpClient: httpClient}
+}
+
+func (c accessTokenClient) Login(ctx context.Context, clientId, clientSecret string) (*AccessToken, error) {
+	token, expiresAt, err := internal.Login(ctx, c.httpClient, apiLoginPath, clientId, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessToken{Token: token, ExpiresAt: expiresAt}, nil
+}
@@ -41,7 +41,7 @@ type meshApiKeyClient struct {
 }
 
 func newApiKeyClient(ctx context.Context, httpClient internal.HttpClient) MeshApiKeyClient {
-	return meshApiKeyClient{internal.NewMeshObjectClient[MeshApiKey](ctx, httpClient, "v1-preview")}
+	return meshApiKeyClient{internal.NewMeshObjectClient[MeshApiKey](ctx, httpClient, []string{"v1-preview"})}
 }
 
 func (c meshApiKeyClient) Create(ctx context.Context, apiKey *MeshApiKey) (*MeshApiKey, error) {
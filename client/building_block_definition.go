@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -60,6 +61,8 @@ type MeshBuildingBlockDefinition struct {
 
 type MeshBuildingBlockDefinitionClient interface {
 	List(ctx context.Context, workspaceIdentifier *string) ([]MeshBuildingBlockDefinition, error)
+	// All streams the building block definitions List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, workspaceIdentifier *string) iter.Seq2[MeshBuildingBlockDefinition, error]
 	Read(ctx context.Context, uuid string) (*MeshBuildingBlockDefinition, error)
 	Create(ctx context.Context, definition MeshBuildingBlockDefinition) (*MeshBuildingBlockDefinition, error)
 	Update(ctx context.Context, uuid string, definition MeshBuildingBlockDefinition) (*MeshBuildingBlockDefinition, error)
@@ -72,7 +75,7 @@ type meshBuildingBlockDefinitionClient struct {
 
 func newBuildingBlockDefinitionClient(ctx context.Context, httpClient internal.HttpClient) MeshBuildingBlockDefinitionClient {
 	return meshBuildingBlockDefinitionClient{
-		meshObject: internal.NewMeshObjectClient[MeshBuildingBlockDefinition](ctx, httpClient, "v1-preview"),
+		meshObject: internal.NewMeshObjectClient[MeshBuildingBlockDefinition](ctx, httpClient, []string{"v1-preview"}),
 	}
 }
 
@@ -85,7 +88,11 @@ type meshBuildingBlockDefinitionListQuery struct {
 }
 
 func (c meshBuildingBlockDefinitionClient) List(ctx context.Context, workspaceIdentifier *string) ([]MeshBuildingBlockDefinition, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(meshBuildingBlockDefinitionListQuery{
+	return internal.Collect(c.All(ctx, workspaceIdentifier))
+}
+
+func (c meshBuildingBlockDefinitionClient) All(ctx context.Context, workspaceIdentifier *string) iter.Seq2[MeshBuildingBlockDefinition, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(meshBuildingBlockDefinitionListQuery{
 		IncludeAllPublished: true,
 		OwnedByWorkspace:    workspaceIdentifier,
 	}))
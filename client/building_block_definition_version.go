@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -184,6 +185,8 @@ type MeshBuildingBlockDefinitionVersion struct {
 // A Get is not required as we always expose all versions of a definition anyway, and a Delete happens together when the definition is deleted.
 type MeshBuildingBlockDefinitionVersionClient interface {
 	List(ctx context.Context, buildingBlockDefinitionUuid string) ([]MeshBuildingBlockDefinitionVersion, error)
+	// All streams the building block definition versions List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, buildingBlockDefinitionUuid string) iter.Seq2[MeshBuildingBlockDefinitionVersion, error]
 	Create(ctx context.Context, ownedByWorkspace string, versionSpec MeshBuildingBlockDefinitionVersionSpec) (*MeshBuildingBlockDefinitionVersion, error)
 	Update(ctx context.Context, uuid, ownedByWorkspace string, versionSpec MeshBuildingBlockDefinitionVersionSpec) (*MeshBuildingBlockDefinitionVersion, error)
 }
@@ -194,7 +197,7 @@ type meshBuildingBlockDefinitionVersionClient struct {
 
 func newBuildingBlockDefinitionVersionClient(ctx context.Context, httpClient internal.HttpClient) MeshBuildingBlockDefinitionVersionClient {
 	return meshBuildingBlockDefinitionVersionClient{
-		meshObject: internal.NewMeshObjectClient[MeshBuildingBlockDefinitionVersion](ctx, httpClient, "v1-preview"),
+		meshObject: internal.NewMeshObjectClient[MeshBuildingBlockDefinitionVersion](ctx, httpClient, []string{"v1-preview"}),
 	}
 }
 
@@ -203,7 +206,11 @@ type meshBuildingBlockDefinitionVersionListQuery struct {
 }
 
 func (c meshBuildingBlockDefinitionVersionClient) List(ctx context.Context, buildingBlockDefinitionUuid string) ([]MeshBuildingBlockDefinitionVersion, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(meshBuildingBlockDefinitionVersionListQuery{
+	return internal.Collect(c.All(ctx, buildingBlockDefinitionUuid))
+}
+
+func (c meshBuildingBlockDefinitionVersionClient) All(ctx context.Context, buildingBlockDefinitionUuid string) iter.Seq2[MeshBuildingBlockDefinitionVersion, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(meshBuildingBlockDefinitionVersionListQuery{
 		BuildingBlockDefinitionUuid: buildingBlockDefinitionUuid,
 	}))
 }
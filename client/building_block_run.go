@@ -45,7 +45,7 @@ type meshBuildingBlockRunClient struct {
 
 func newBuildingBlockRunClient(ctx context.Context, httpClient internal.HttpClient) MeshBuildingBlockRunClient {
 	return meshBuildingBlockRunClient{
-		meshObject: internal.NewMeshObjectClient[MeshBuildingBlockRun](ctx, httpClient, "v1"),
+		meshObject: internal.NewMeshObjectClient[MeshBuildingBlockRun](ctx, httpClient, []string{"v1"}),
 	}
 }
 
@@ -55,6 +55,6 @@ func (c meshBuildingBlockRunClient) GetLogs(ctx context.Context, runUuid string)
 		c.meshObject.HttpClient,
 		"GET",
 		c.meshObject.ApiUrl.JoinPath(runUuid, "logs"),
-		internal.WithAccept(c.meshObject.MeshObjectMimeType()),
+		c.meshObject.WithMeshObjectAccept(ctx),
 	)
 }
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"slices"
+	"time"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -45,6 +47,7 @@ type MeshBuildingBlockV2 struct {
 type MeshBuildingBlockV2Metadata struct {
 	Uuid             *string `json:"uuid" tfsdk:"uuid"`
 	OwnedByWorkspace string  `json:"ownedByWorkspace" tfsdk:"owned_by_workspace"`
+	CreatedOn        string  `json:"createdOn,omitempty" tfsdk:"-"`
 }
 
 type MeshBuildingBlockV2Spec struct {
@@ -282,6 +285,8 @@ type MeshBuildingBlockV2Client interface {
 	Read(ctx context.Context, uuid string) (*MeshBuildingBlockV2, error)
 	ReadFunc(uuid string) func(ctx context.Context) (*MeshBuildingBlockV2, error)
 	List(ctx context.Context, filter MeshBuildingBlockV2ListFilter) ([]MeshBuildingBlockV2, error)
+	// All streams the building blocks List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, filter MeshBuildingBlockV2ListFilter) iter.Seq2[MeshBuildingBlockV2, error]
 	Create(ctx context.Context, bb *MeshBuildingBlockV2) (*MeshBuildingBlockV2, error)
 	Update(ctx context.Context, bb *MeshBuildingBlockV2) (*MeshBuildingBlockV2, error)
 	Delete(ctx context.Context, uuid string, purge bool) error
@@ -293,7 +298,7 @@ type meshBuildingBlockV2Client struct {
 }
 
 func newBuildingBlockV2Client(ctx context.Context, httpClient internal.HttpClient) MeshBuildingBlockV2Client {
-	return meshBuildingBlockV2Client{internal.NewMeshObjectClient[MeshBuildingBlockV2](ctx, httpClient, "v2-preview")}
+	return meshBuildingBlockV2Client{internal.NewMeshObjectClient[MeshBuildingBlockV2](ctx, httpClient, []string{"v2-preview"})}
 }
 
 func (c meshBuildingBlockV2Client) Read(ctx context.Context, uuid string) (*MeshBuildingBlockV2, error) {
@@ -307,18 +312,50 @@ func (c meshBuildingBlockV2Client) ReadFunc(uuid string) func(ctx context.Contex
 }
 
 func (c meshBuildingBlockV2Client) List(ctx context.Context, filter MeshBuildingBlockV2ListFilter) ([]MeshBuildingBlockV2, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(filter))
+	return internal.Collect(c.All(ctx, filter))
 }
 
+func (c meshBuildingBlockV2Client) All(ctx context.Context, filter MeshBuildingBlockV2ListFilter) iter.Seq2[MeshBuildingBlockV2, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(filter))
+}
+
+// Create is retried on e.g. a 503, see internal.MeshObjectClient.PostIdempotently. A building block
+// orphaned by an earlier attempt is found by its definition version, target and display name.
 func (c meshBuildingBlockV2Client) Create(ctx context.Context, bb *MeshBuildingBlockV2) (*MeshBuildingBlockV2, error) {
-	return c.meshObject.Post(ctx, bb)
+	return c.meshObject.PostIdempotently(ctx, bb, func(ctx context.Context, firstAttempt time.Time) (*MeshBuildingBlockV2, error) {
+		filter := MeshBuildingBlockV2ListFilter{
+			Name:        &bb.Spec.DisplayName,
+			VersionUuid: &bb.Spec.BuildingBlockDefinitionVersionRef.Uuid,
+			TargetKind:  &bb.Spec.TargetRef.Kind,
+		}
+		if bb.Spec.TargetRef.Kind == MeshObjectKind.Tenant {
+			filter.TenantUuid = bb.Spec.TargetRef.Uuid
+		} else {
+			filter.WorkspaceIdentifier = bb.Spec.TargetRef.Name
+		}
+		candidates, err := c.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		return onlyOrphan(candidates, func(candidate MeshBuildingBlockV2) bool {
+			return candidate.Spec.DisplayName == bb.Spec.DisplayName &&
+				candidate.Spec.BuildingBlockDefinitionVersionRef.Uuid == bb.Spec.BuildingBlockDefinitionVersionRef.Uuid &&
+				candidate.Spec.TargetRef.Kind == bb.Spec.TargetRef.Kind &&
+				equalPointees(candidate.Spec.TargetRef.Uuid, bb.Spec.TargetRef.Uuid) &&
+				equalPointees(candidate.Spec.TargetRef.Name, bb.Spec.TargetRef.Name) &&
+				createdAfter(candidate.Metadata.CreatedOn, firstAttempt)
+		}), nil
+	})
 }
 
 func (c meshBuildingBlockV2Client) Update(ctx context.Context, bb *MeshBuildingBlockV2) (*MeshBuildingBlockV2, error) {
 	if bb.Metadata.Uuid == nil {
 		return nil, fmt.Errorf("cannot update building block without UUID")
 	}
-	return c.meshObject.Put(ctx, *bb.Metadata.Uuid, bb)
+	// createdOn is set by meshStack, and only read to find orphans in Create.
+	payload := *bb
+	payload.Metadata.CreatedOn = ""
+	return c.meshObject.Put(ctx, *bb.Metadata.Uuid, payload)
 }
 
 func (c meshBuildingBlockV2Client) Delete(ctx context.Context, uuid string, purge bool) error {
@@ -400,7 +437,7 @@ func (c meshBuildingBlockV2Client) TriggerRun(ctx context.Context, bbUuid string
 		c.meshObject.HttpClient,
 		"POST",
 		c.meshObject.ApiUrl.JoinPath(bbUuid, "trigger-run"),
-		internal.WithAccept(c.meshObject.MeshObjectMimeType()),
+		c.meshObject.WithMeshObjectAccept(ctx),
 	)
 	return err
 }
@@ -2,11 +2,16 @@ package client
 
 import (
 	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types/enum"
 )
 
@@ -305,3 +310,29 @@ func assertSentUnderBothFieldNames(t *testing.T, spec MeshBuildingBlockV2Spec) {
 	assert.JSONEq(t, "["+testParentRef+"]", string(request["parentBuildingBlockRefs"]))
 	assert.JSONEq(t, "["+testDeprecatedParent+"]", string(request["parentBuildingBlocks"]))
 }
+
+func TestMeshBuildingBlockV2Client_UpdateOmitsCreatedOn(t *testing.T) {
+	var sent map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &sent))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	rootUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	bbClient := newBuildingBlockV2Client(t.Context(), internal.NewHttpClient(rootUrl, "test-agent", NewApiTokenAuthorization("token")))
+
+	bb := &MeshBuildingBlockV2{Metadata: MeshBuildingBlockV2Metadata{
+		Uuid:             new("bb-uuid"),
+		OwnedByWorkspace: "my-workspace",
+		CreatedOn:        "2026-01-01T00:00:00Z",
+	}}
+	_, err = bbClient.Update(t.Context(), bb)
+	require.NoError(t, err)
+	require.NotNil(t, sent)
+	assert.NotContains(t, sent["metadata"], "createdOn")
+	assert.Equal(t, "2026-01-01T00:00:00Z", bb.Metadata.CreatedOn, "must not modify the caller's building block")
+}
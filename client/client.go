@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
@@ -17,7 +18,14 @@ var MinMeshStackVersion = version.MustParse("2026.34.0")
 // This error is returned when an HTTP request fails with a non-2XX status code.
 type HttpError = internal.HttpError
 
+// FieldViolation is a validation error meshStack reports for a single field, see HttpError.Violations.
+type FieldViolation = internal.FieldViolation
+
+// BackendUnavailableError is returned when a request waited in vain for an unavailable meshStack backend to recover.
+type BackendUnavailableError = internal.BackendUnavailableError
+
 type Client struct {
+	AccessToken                    AccessTokenClient
 	ApiKey                         MeshApiKeyClient
 	BuildingBlock                  MeshBuildingBlockClient
 	BuildingBlockV2                MeshBuildingBlockV2Client
@@ -29,6 +37,7 @@ type Client struct {
 	LandingZone                    MeshLandingZoneClient
 	Location                       MeshLocationClient
 	MeshInfo                       MeshInfoClient
+	MeshObject                     MeshObjectClient
 	PaymentMethod                  MeshPaymentMethodClient
 	Platform                       MeshPlatformClient
 	PlatformType                   MeshPlatformTypeClient
@@ -49,15 +58,84 @@ func NewApiTokenAuthorization(apiToken string) Authorization {
 	return internal.BearerTokenAuthorization{Token: apiToken}
 }
 
-const apiLoginPath = "/api/login"
+const (
+	apiLoginPath     = "/api/login"
+	meshInfoPath     = "/mesh/info"
+	apiOidcLoginPath = "/api/login/oidc"
+)
 
 func NewApiKeyAuthorization(apiKey, apiSecret string) Authorization {
-	return internal.NewClientSecretAuthorization(apiLoginPath, apiKey, apiSecret)
+	return internal.NewClientSecretAuthorization(apiLoginPath, apiKey, apiSecret, nil)
 }
 
-func New(ctx context.Context, rootUrl *url.URL, userAgent string, auth Authorization) (Client, error) {
-	httpClient := internal.WithRetry(
-		internal.NewHttpClient(rootUrl, userAgent, auth),
+// NewCachedApiKeyAuthorization works like NewApiKeyAuthorization, but shares the access token with
+// other processes through a file cache in the user's cache directory (see [os.UserCacheDir]),
+// keyed by endpoint and API key. This avoids a login for every process Terraform starts during a run.
+func NewCachedApiKeyAuthorization(apiKey, apiSecret string) (Authorization, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine token cache directory: %w", err)
+	}
+	cache := &internal.TokenCache{Dir: filepath.Join(userCacheDir, "terraform-provider-meshstack", "tokens")}
+	return internal.NewClientSecretAuthorization(apiLoginPath, apiKey, apiSecret, cache), nil
+}
+
+// OidcTokenSource provides the federated workload-identity JWT for NewOidcAuthorization.
+// See OidcTokenFromFile and OidcTokenFromEnv.
+type OidcTokenSource = internal.IdTokenSource
+
+// NewOidcAuthorization authenticates with a federated workload-identity JWT (e.g. issued by GitHub
+// Actions or GitLab CI), which is exchanged for a short-lived meshStack access token. The token is
+// renewed by another exchange before it expires, reading the JWT from idToken again.
+func NewOidcAuthorization(clientId, audience string, idToken OidcTokenSource) Authorization {
+	return internal.NewOidcAuthorization(apiOidcLoginPath, clientId, audience, idToken)
+}
+
+// OidcTokenFromFile reads the JWT from the given file on every exchange, so a CI system may rotate it.
+func OidcTokenFromFile(path string) OidcTokenSource {
+	return func() (string, error) {
+		token, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return string(token), nil
+	}
+}
+
+// OidcTokenFromEnv reads the JWT from the given environment variable on every exchange.
+func OidcTokenFromEnv(key string) OidcTokenSource {
+	return func() (string, error) {
+		token, ok := os.LookupEnv(key)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", key)
+		}
+		return token, nil
+	}
+}
+
+func New(ctx context.Context, rootUrl *url.URL, userAgent string, auth Authorization, opts ...Option) (Client, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	httpClient, err := internal.WithTransport(internal.NewHttpClient(rootUrl, userAgent, auth), o.transport)
+	if err != nil {
+		return Client{}, err
+	}
+	if cassettePath := os.Getenv("MESHSTACK_CASSETTE"); cassettePath != "" {
+		// Test support: record the traffic to, or replay it from, a cassette instead of the network.
+		mode := internal.CassetteReplay
+		if modeValue := os.Getenv("MESHSTACK_CASSETTE_MODE"); modeValue != "" {
+			mode = internal.CassetteMode(modeValue)
+		}
+		if httpClient, err = internal.WithCassette(httpClient, internal.CassetteOptions{Mode: mode, Path: cassettePath}); err != nil {
+			return Client{}, err
+		}
+	}
+	httpClient = internal.WithRetry(
+		// Rate limiting sits below the retry layer, so that retries are rate limited as well.
+		internal.WithRateLimit(httpClient, o.rateLimit),
 		internal.RetryOptions{
 			// Sized to ride out a full meshStack backend restart (e.g. an OOMKill followed by a
 			// Spring Boot cold start), which can leave the gateway returning 503 for ~2-3 minutes —
@@ -65,9 +143,23 @@ func New(ctx context.Context, rootUrl *url.URL, userAgent string, auth Authoriza
 			// 1+2+4+8+16+30*7 seconds.
 			MaxRetries:       12,
 			Backoff:          internal.ExponentialBackoff{MinWait: 1 * time.Second, MaxWait: 30 * time.Second},
-			WhitelistedPaths: map[string][]string{"POST": {apiLoginPath}},
+			WhitelistedPaths: map[string][]string{"POST": {apiLoginPath, apiOidcLoginPath}},
+			// During a backend outage, all requests wait for a single probe of the public /mesh/info endpoint
+			// instead of retrying on their own, and fail with "backend unavailable" after the same ~4 minutes.
+			CircuitBreaker: internal.CircuitBreakerOptions{
+				FailureThreshold: 5,
+				ProbePath:        meshInfoPath,
+				ProbeBackoff:     internal.ExponentialBackoff{MinWait: 1 * time.Second, MaxWait: 30 * time.Second},
+				MaxWait:          4 * time.Minute,
+			},
 		},
 	)
+	httpClient.PinnedApiVersions = o.pinnedApiVersions
+	// Negotiating an API version sends a request as well, so opting out of the version check opts out of that too.
+	httpClient.SkipApiVersionNegotiation = skipVersionCheck()
+	httpClient.ListPageSize = o.listPageSize
+	// Shared by all clients below, so that a meshObject read by many resources and data sources of a run is requested once.
+	httpClient.ReadCache = internal.NewReadCache()
 
 	meshInfoClient := newMeshInfoClient(httpClient)
 	if err := checkMeshVersion(ctx, meshInfoClient); err != nil {
@@ -75,6 +167,7 @@ func New(ctx context.Context, rootUrl *url.URL, userAgent string, auth Authoriza
 	}
 
 	return Client{
+		AccessToken:                    newAccessTokenClient(httpClient),
 		ApiKey:                         newApiKeyClient(ctx, httpClient),
 		BuildingBlock:                  newBuildingBlockClient(ctx, httpClient),
 		BuildingBlockV2:                newBuildingBlockV2Client(ctx, httpClient),
@@ -86,6 +179,7 @@ func New(ctx context.Context, rootUrl *url.URL, userAgent string, auth Authoriza
 		LandingZone:                    newLandingZoneClient(ctx, httpClient),
 		Location:                       newLocationClient(ctx, httpClient),
 		MeshInfo:                       meshInfoClient,
+		MeshObject:                     newMeshObjectClient(httpClient),
 		PaymentMethod:                  newPaymentMethodClient(ctx, httpClient),
 		Platform:                       newPlatformClient(ctx, httpClient),
 		PlatformType:                   newPlatformTypeClient(ctx, httpClient),
@@ -101,11 +195,47 @@ func New(ctx context.Context, rootUrl *url.URL, userAgent string, auth Authoriza
 	}, nil
 }
 
+// WithoutReadCache makes the requests with the returned context bypass the read cache of the client,
+// which otherwise answers repeated reads of a meshObject until the client changes a meshObject of the
+// same kind. Use it where changes by meshStack itself matter, e.g. when polling a meshObject's status.
+func WithoutReadCache(ctx context.Context) context.Context {
+	return internal.WithoutReadCache(ctx)
+}
+
+// onlyOrphan returns the only candidate matching the meshObject a retried create was meant to create,
+// see internal.MeshObjectClient.PostIdempotently. It returns nil if there is none or more than one, as
+// adopting the wrong meshObject is worse than failing.
+func onlyOrphan[M any](candidates []M, matches func(candidate M) bool) *M {
+	var orphan *M
+	for _, candidate := range candidates {
+		if matches(candidate) {
+			if orphan != nil {
+				return nil
+			}
+			orphan = &candidate
+		}
+	}
+	return orphan
+}
+
+// createdAfter tells whether the createdOn timestamp of a meshObject is not before t, at the
+// timestamp's resolution of a second. t must be in meshStack's time, see
+// internal.MeshObjectClient.PostIdempotently. A missing or unparsable timestamp is not, so that an
+// orphan candidate of unknown age is never adopted.
+func createdAfter(createdOn string, t time.Time) bool {
+	created, err := time.Parse(time.RFC3339, createdOn)
+	return err == nil && !created.Before(t.Truncate(time.Second))
+}
+
+func equalPointees[T comparable](a, b *T) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
 func checkMeshVersion(ctx context.Context, meshInfoClient MeshInfoClient) error {
 	// Skip before the request, not just before the comparison: /mesh/info is a GET on the retrying
 	// client, so an unavailable backend blocks provider configuration for the whole retry budget
 	// (~4 minutes) and then fails it. Opting out of the check has to opt out of that too.
-	if os.Getenv("MESHSTACK_SKIP_VERSION_CHECK") == "true" {
+	if skipVersionCheck() {
 		return nil
 	}
 
@@ -122,3 +252,7 @@ func checkMeshVersion(ctx context.Context, meshInfoClient MeshInfoClient) error
 	}
 	return nil
 }
+
+func skipVersionCheck() bool {
+	return os.Getenv("MESHSTACK_SKIP_VERSION_CHECK") == "true"
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"math"
+	"net/url"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
+)
+
+// Option configures the client created by New.
+type Option func(*options)
+
+type options struct {
+	rateLimit         internal.RateLimitOptions
+	transport         internal.TransportOptions
+	pinnedApiVersions map[string]string
+	listPageSize      int
+}
+
+// WithRateLimit limits the requests sent to meshStack to requestsPerSecond (allowing bursts of up to
+// one second's worth of requests) and to maxConcurrentRequests in flight at the same time. A zero
+// value leaves the respective limit off.
+//
+// Independent of these limits, the client always slows down when meshStack asks it to with a
+// Retry-After header.
+func WithRateLimit(requestsPerSecond float64, maxConcurrentRequests int) Option {
+	return func(o *options) {
+		o.rateLimit = internal.RateLimitOptions{
+			RequestsPerSecond: requestsPerSecond,
+			Burst:             int(math.Ceil(requestsPerSecond)),
+			MaxInFlight:       maxConcurrentRequests,
+		}
+	}
+}
+
+// WithCaCertificates trusts the given PEM-encoded CA certificates in addition to the system's,
+// e.g. the CA of a TLS-intercepting corporate proxy.
+func WithCaCertificates(caCertPem []byte) Option {
+	return func(o *options) {
+		o.transport.CaCertPem = caCertPem
+	}
+}
+
+// WithClientCertificate presents the given PEM-encoded client certificate and key (mutual TLS).
+func WithClientCertificate(certPem, keyPem []byte) Option {
+	return func(o *options) {
+		o.transport.ClientCertPem, o.transport.ClientKeyPem = certPem, keyPem
+	}
+}
+
+// WithProxy sends all requests through the given proxy instead of the one from the HTTP_PROXY/HTTPS_PROXY
+// environment variables.
+func WithProxy(proxyUrl *url.URL) Option {
+	return func(o *options) {
+		o.transport.ProxyUrl = proxyUrl
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of meshStack's TLS certificate. For development only.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) {
+		o.transport.InsecureSkipVerify = true
+	}
+}
+
+// WithApiVersions pins the API version by meshObject kind, e.g. {"meshBuildingBlock": "v2-preview"},
+// instead of using the newest version supported by both the client and meshStack. A pinned version the
+// client was not checked against is used with a warning, e.g. to opt into the GA version of a preview API.
+func WithApiVersions(apiVersionsByKind map[string]string) Option {
+	return func(o *options) {
+		o.pinnedApiVersions = apiVersionsByKind
+	}
+}
+
+// WithListPageSize requests lists of meshObjects with the given number of meshObjects per page, instead
+// of meshStack's default. Larger pages mean fewer requests for long lists.
+func WithListPageSize(size int) Option {
+	return func(o *options) {
+		o.listPageSize = size
+	}
+}
@@ -3,7 +3,9 @@ package client
 import (
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,3 +44,63 @@ func TestCheckMeshVersion_SkipsRequestWhenOptedOut(t *testing.T) {
 		assert.Equal(t, 1, transport.calls)
 	})
 }
+
+func TestNew_SendsNoRequestWhenOptedOutOfVersionCheck(t *testing.T) {
+	t.Setenv("MESHSTACK_SKIP_VERSION_CHECK", "true")
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	rootUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	_, err = New(t.Context(), rootUrl, "test-agent", NewApiTokenAuthorization("token"))
+	require.NoError(t, err)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Empty(t, requests, "neither the version check nor the API version negotiation may send a request")
+}
+
+func TestWithListPageSize(t *testing.T) {
+	t.Setenv("MESHSTACK_SKIP_VERSION_CHECK", "true")
+	var mu sync.Mutex
+	var tenantListSizes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/meshobjects/meshtenants" {
+			mu.Lock()
+			tenantListSizes = append(tenantListSizes, r.URL.Query().Get("size"))
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"_embedded": {"meshTenants": []}, "page": {"totalPages": 1, "number": 0}}`))
+	}))
+	t.Cleanup(server.Close)
+	rootUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	for name, test := range map[string]struct {
+		options  []Option
+		wantSize string
+	}{
+		"default page size": {wantSize: ""},
+		"custom page size":  {options: []Option{WithListPageSize(250)}, wantSize: "250"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := New(t.Context(), rootUrl, "test-agent", NewApiTokenAuthorization("token"), test.options...)
+			require.NoError(t, err)
+			mu.Lock()
+			tenantListSizes = nil
+			mu.Unlock()
+
+			tenants, err := c.Tenant.List(t.Context(), MeshTenantQuery{Workspace: "my-workspace"})
+			require.NoError(t, err)
+			assert.Empty(t, tenants)
+			assert.Equal(t, []string{test.wantSize}, tenantListSizes)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package client
+
+import "github.com/meshcloud/terraform-provider-meshstack/client/internal"
+
+// Tracer exposes tracing of the requests to meshStack, with one span per request.
+type Tracer = internal.Tracer
+
+// Span is a span started by Tracer.
+type Span = internal.Span
+
+// SetTracer allows setting the client tracer. By default, no tracing happens.
+//
+// Spans are named "<method> <path template>" and have the OpenTelemetry HTTP client attributes
+// http.request.method, url.template, http.response.status_code and, if the request was retried,
+// http.request.resend_count. Requests to the meshObject API additionally have meshstack.kind,
+// e.g. meshProject, and reads meshstack.read_cache, which is hit, coalesced or miss (see WithoutReadCache).
+// The path template replaces identifiers by {id}, as in /api/meshobjects/meshprojects/{id}.
+func SetTracer(tracer Tracer) {
+	internal.Tracing = tracer
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -50,6 +51,8 @@ type MeshIntegrationClient interface {
 	Update(ctx context.Context, integration MeshIntegration) (*MeshIntegration, error)
 	Delete(ctx context.Context, uuid string) error
 	List(ctx context.Context) ([]MeshIntegration, error)
+	// All streams the integrations List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context) iter.Seq2[MeshIntegration, error]
 }
 
 type meshIntegrationClientImpl struct {
@@ -57,7 +60,7 @@ type meshIntegrationClientImpl struct {
 }
 
 func newIntegrationClient(ctx context.Context, httpClient internal.HttpClient) MeshIntegrationClient {
-	return meshIntegrationClientImpl{internal.NewMeshObjectClient[MeshIntegration](ctx, httpClient, "v1")}
+	return meshIntegrationClientImpl{internal.NewMeshObjectClient[MeshIntegration](ctx, httpClient, []string{"v1"})}
 }
 
 func (c meshIntegrationClientImpl) Create(ctx context.Context, integration MeshIntegration) (*MeshIntegration, error) {
@@ -77,5 +80,9 @@ func (c meshIntegrationClientImpl) Delete(ctx context.Context, uuid string) erro
 }
 
 func (c meshIntegrationClientImpl) List(ctx context.Context) ([]MeshIntegration, error) {
-	return c.meshObject.List(ctx)
+	return internal.Collect(c.All(ctx))
+}
+
+func (c meshIntegrationClientImpl) All(ctx context.Context) iter.Seq2[MeshIntegration, error] {
+	return c.meshObject.All(ctx)
 }
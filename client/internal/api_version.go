@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiVersionProbeTimeout bounds the request negotiating an API version. It is not retried, so that an
+// unavailable backend delays the first request of a kind by at most this long, before the request itself
+// rides out the outage with retries.
+const apiVersionProbeTimeout = 10 * time.Second
+
+// apiVersionNegotiation negotiates the API version of a MeshObjectClient with its first request and
+// keeps it for all further ones, so that only the kinds actually used are negotiated.
+type apiVersionNegotiation struct {
+	apiVersions []string
+
+	mu sync.Mutex
+	// apiVersion is empty until negotiated.
+	apiVersion string
+}
+
+// get returns the negotiated API version, negotiating it first if needed. Concurrent first requests
+// wait for a single negotiation.
+func (n *apiVersionNegotiation) get(ctx context.Context, httpClient HttpClient, kind string, apiUrl *url.URL) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.apiVersion != "" {
+		return n.apiVersion
+	}
+	apiVersion, reason := negotiateApiVersion(ctx, httpClient, kind, apiUrl, n.apiVersions)
+	if ctx.Err() != nil {
+		// The request is canceled anyway, let a later one negotiate properly.
+		return apiVersion
+	}
+	Log.Info(ctx, "picked API version", "kind", kind, "version", apiVersion, "versionReason", reason)
+	if reason == apiVersionNegotiationFailed {
+		// Likely a transient failure, e.g. a timeout, so let the next request negotiate again rather than
+		// pinning the oldest version for the whole process.
+		return apiVersion
+	}
+	n.apiVersion = apiVersion
+	return apiVersion
+}
+
+// apiVersionNegotiationFailed is the reason of negotiateApiVersion for falling back to the oldest version
+// as meshStack could not tell which one it serves.
+const apiVersionNegotiationFailed = "negotiation failed"
+
+// negotiateApiVersion picks the API version of kind from apiVersions, which lists the versions the client
+// supports, the newest first. These must only be versions the client's DTOs were checked against: a GA
+// version meshStack does not serve yet may well come with a different shape than its preview.
+//
+// A version pinned in HttpClient.PinnedApiVersions is used as is, even if not in apiVersions, so that early
+// adopters can opt into a version meshStack serves before the provider lists it. Otherwise, if there is
+// more than one version to pick from, meshStack is asked with a single-item list request accepting all of
+// them, the newer ones preferred, and the version of the served media type is used. If meshStack cannot
+// tell (e.g. the list request is forbidden or times out), or HttpClient.SkipApiVersionNegotiation is set,
+// the oldest version is used, which every meshStack the client supports serves, see
+// client.MinMeshStackVersion.
+//
+// reason tells how the version was picked, for logging.
+func negotiateApiVersion(ctx context.Context, httpClient HttpClient, kind string, apiUrl *url.URL, apiVersions []string) (apiVersion, reason string) {
+	oldest := apiVersions[len(apiVersions)-1]
+	if pinned, ok := httpClient.PinnedApiVersions[kind]; ok {
+		switch {
+		case slices.Contains(apiVersions, pinned):
+			return pinned, "pinned"
+		case meshObjectApiVersionRe.MatchString(pinned):
+			Log.Warn(ctx, "using pinned API version this provider version was not checked against", "kind", kind, "pinned", pinned, "supported", strings.Join(apiVersions, ", "))
+			return pinned, "pinned"
+		default:
+			Log.Warn(ctx, "ignoring invalid pinned API version", "kind", kind, "pinned", pinned, "supported", strings.Join(apiVersions, ", "))
+		}
+	}
+	if len(apiVersions) == 1 {
+		return oldest, "only supported version"
+	}
+	if httpClient.SkipApiVersionNegotiation {
+		return oldest, "negotiation skipped"
+	}
+
+	var accept []string
+	for i, version := range apiVersions {
+		mediaType := meshObjectMimeType(kind, version)
+		if i > 0 {
+			// Decreasing quality values make meshStack prefer the newer versions.
+			mediaType += fmt.Sprintf(";q=%.1f", max(1-0.1*float64(i), 0.1))
+		}
+		accept = append(accept, mediaType)
+	}
+	probeCtx, cancel := context.WithTimeout(withoutRetry(ctx), apiVersionProbeTimeout)
+	defer cancel()
+	var contentType string
+	_, err := DoAuthorizedRequest[any](probeCtx, httpClient, http.MethodGet, apiUrl,
+		WithAccept(strings.Join(accept, ", ")),
+		WithUrlQuery(map[string]any{"page": 0, "size": 1}),
+		withTracedMeshObject(kind, apiUrl.Path),
+		withResponseHeader("Content-Type", &contentType),
+	)
+	if err != nil {
+		Log.Warn(ctx, "cannot negotiate API version, using the oldest supported one", "kind", kind, "error", err.Error())
+		return oldest, apiVersionNegotiationFailed
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	for _, version := range apiVersions {
+		if strings.EqualFold(mediaType, meshObjectMimeType(kind, version)) {
+			return version, "negotiated"
+		}
+	}
+	Log.Warn(ctx, "meshStack served an unexpected media type, using the oldest supported API version", "kind", kind, "contentType", contentType)
+	return oldest, apiVersionNegotiationFailed
+}
@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateApiVersion(t *testing.T) {
+	newClient := func(t *testing.T, pinned map[string]string, handler http.HandlerFunc) (client MeshObjectClient[MeshTestObject], requests *atomic.Int32) {
+		t.Helper()
+		requests = new(atomic.Int32)
+		httpClient := WithRetry(newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+			handler(resp, req)
+		}), RetryOptions{MaxRetries: 3, Backoff: &retryTestBackoff{}})
+		httpClient.Authorization = BearerTokenAuthorization{Token: "some-token"}
+		httpClient.PinnedApiVersions = pinned
+		client = NewMeshObjectClient[MeshTestObject](t.Context(), httpClient, []string{"v2", "v2-preview"})
+		assert.EqualValues(t, 0, requests.Load(), "the API version must be negotiated with the first request, not before")
+		return client, requests
+	}
+	serveVersion := func(version string) http.HandlerFunc {
+		return func(resp http.ResponseWriter, req *http.Request) {
+			resp.Header().Set("Content-Type", meshObjectMimeType("meshTestObject", version)+";charset=UTF-8")
+			_, _ = resp.Write([]byte(`{"_embedded":{}, "page":{"totalPages":0}}`))
+		}
+	}
+
+	t.Run("newest version served by meshStack", func(t *testing.T) {
+		var accept, query string
+		client, requests := newClient(t, nil, func(resp http.ResponseWriter, req *http.Request) {
+			accept, query = req.Header.Get("Accept"), req.URL.RawQuery
+			serveVersion("v2")(resp, req)
+		})
+		assert.Equal(t, "v2", client.ApiVersion(t.Context()))
+		assert.Equal(t, "application/vnd.meshcloud.api.meshTestObject.v2.hal+json, application/vnd.meshcloud.api.meshTestObject.v2-preview.hal+json;q=0.9", accept)
+		assert.Equal(t, "page=0&size=1", query)
+		assert.EqualValues(t, 1, requests.Load())
+
+		assert.Equal(t, "v2", client.ApiVersion(t.Context()))
+		assert.EqualValues(t, 1, requests.Load(), "the negotiated API version must be kept")
+	})
+
+	t.Run("older version served by meshStack", func(t *testing.T) {
+		client, _ := newClient(t, nil, serveVersion("v2-preview"))
+		assert.Equal(t, "v2-preview", client.ApiVersion(t.Context()))
+	})
+
+	t.Run("oldest version if negotiation fails", func(t *testing.T) {
+		testLogger := installTestLogger(t)
+		var failing atomic.Bool
+		failing.Store(true)
+		client, requests := newClient(t, nil, func(resp http.ResponseWriter, req *http.Request) {
+			if failing.Load() {
+				resp.WriteHeader(http.StatusForbidden)
+				return
+			}
+			serveVersion("v2")(resp, req)
+		})
+		assert.Equal(t, "v2-preview", client.ApiVersion(t.Context()))
+		require.Len(t, testLogger.Warns, 1)
+		assert.Contains(t, testLogger.Warns[0], "cannot negotiate API version")
+
+		failing.Store(false)
+		assert.Equal(t, "v2", client.ApiVersion(t.Context()), "the fallback must not be kept")
+		assert.EqualValues(t, 2, requests.Load())
+	})
+
+	t.Run("oldest version without retrying if meshStack is unavailable", func(t *testing.T) {
+		client, requests := newClient(t, nil, func(resp http.ResponseWriter, req *http.Request) {
+			resp.WriteHeader(http.StatusServiceUnavailable)
+		})
+		assert.Equal(t, "v2-preview", client.ApiVersion(t.Context()))
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("oldest version without negotiation if skipped", func(t *testing.T) {
+		requests := new(atomic.Int32)
+		httpClient := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+		})
+		httpClient.SkipApiVersionNegotiation = true
+		client := NewMeshObjectClient[MeshTestObject](t.Context(), httpClient, []string{"v2", "v2-preview"})
+		assert.Equal(t, "v2-preview", client.ApiVersion(t.Context()))
+		assert.EqualValues(t, 0, requests.Load())
+	})
+
+	t.Run("pinned version without negotiation", func(t *testing.T) {
+		client, requests := newClient(t, map[string]string{"meshTestObject": "v2-preview"}, serveVersion("v2"))
+		assert.Equal(t, "v2-preview", client.ApiVersion(t.Context()))
+		assert.EqualValues(t, 0, requests.Load())
+	})
+
+	t.Run("unchecked pinned version is used with a warning", func(t *testing.T) {
+		testLogger := installTestLogger(t)
+		client, requests := newClient(t, map[string]string{"meshTestObject": "v3"}, serveVersion("v2"))
+		assert.Equal(t, "v3", client.ApiVersion(t.Context()))
+		assert.EqualValues(t, 0, requests.Load())
+		require.Len(t, testLogger.Warns, 1)
+		assert.Contains(t, testLogger.Warns[0], "not checked against")
+	})
+
+	t.Run("invalid pinned version is ignored", func(t *testing.T) {
+		testLogger := installTestLogger(t)
+		client, _ := newClient(t, map[string]string{"meshTestObject": "latest"}, serveVersion("v2"))
+		assert.Equal(t, "v2", client.ApiVersion(t.Context()))
+		require.Len(t, testLogger.Warns, 1)
+		assert.Contains(t, testLogger.Warns[0], "latest")
+	})
+}
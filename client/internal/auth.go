@@ -2,8 +2,10 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 )
@@ -12,11 +14,40 @@ type Authorization interface {
 	Header(ctx context.Context, client HttpClient) (string, error)
 }
 
-func NewClientSecretAuthorization(loginApiPath, clientId, clientSecret string) Authorization {
+// rejectableAuthorization is an Authorization that obtains its tokens itself and can forget one meshStack
+// rejected with a 401, so that the next request gets a new one, see DoAuthorizedRequest.
+type rejectableAuthorization interface {
+	Authorization
+	reject(ctx context.Context, client HttpClient, header string)
+}
+
+// minimumTokenLifetime is the remaining lifetime below which a token obtained by login is renewed
+// before the next request, so it cannot expire while that request is in flight.
+const minimumTokenLifetime = 30 * time.Second
+
+// NewClientSecretAuthorization logs in at loginApiPath with the given client credentials.
+// If cache is not nil, the access token is shared with other processes through it.
+func NewClientSecretAuthorization(loginApiPath, clientId, clientSecret string, cache *TokenCache) Authorization {
 	return &clientSecretAuthorization{
 		LoginApiPath: loginApiPath,
 		ClientId:     clientId,
 		ClientSecret: clientSecret,
+		Cache:        cache,
+	}
+}
+
+// IdTokenSource returns the current federated workload-identity JWT, e.g. read from a file a CI
+// system keeps up to date. It is called on every token exchange, so a rotated JWT is picked up.
+type IdTokenSource func() (string, error)
+
+// NewOidcAuthorization exchanges the JWT provided by idToken for a meshStack access token at
+// tokenApiPath. The audience is optional and forwarded for meshStack to verify the JWT's aud claim.
+func NewOidcAuthorization(tokenApiPath, clientId, audience string, idToken IdTokenSource) Authorization {
+	return &oidcAuthorization{
+		TokenApiPath: tokenApiPath,
+		ClientId:     clientId,
+		Audience:     audience,
+		IdToken:      idToken,
 	}
 }
 
@@ -34,6 +65,7 @@ type clientSecretAuthorization struct {
 	ClientId     string
 	ClientSecret string
 	ExpiresAt    time.Time
+	Cache        *TokenCache
 	mu           sync.Mutex
 }
 
@@ -47,31 +79,140 @@ func (auth *clientSecretAuthorization) Header(ctx context.Context, client HttpCl
 }
 
 func (auth *clientSecretAuthorization) ensureValidToken(ctx context.Context, client HttpClient) error {
-	const minimumTokenLifetime = 30 * time.Second
 	if auth.Token != "" && time.Until(auth.ExpiresAt) > minimumTokenLifetime {
 		return nil
 	}
 
-	loginApiUrl := client.RootUrl.JoinPath(auth.LoginApiPath)
+	if auth.Cache != nil {
+		cacheKey := tokenCacheKey(client.RootUrl, auth.ClientId)
+		// Hold the lock while logging in, so that concurrent processes wait for this login instead of
+		// logging in as well. The cache is an optimization only, so failing to use it is not fatal.
+		unlock, err := auth.Cache.lock(ctx, cacheKey)
+		if errors.Is(err, errTokenCacheLockTimeout) {
+			// Most likely a crashed process left the lock behind, see tokenCacheStaleLockAge.
+			Log.Warn(ctx, "token cache lock held too long, logging in without the cache", "waited", tokenCacheLockTimeout)
+			return auth.login(ctx, client)
+		}
+		if err != nil {
+			Log.Warn(ctx, "cannot lock token cache, logging in without it", "error", err.Error())
+			return auth.login(ctx, client)
+		}
+		defer unlock()
+		if cached, found := auth.Cache.load(cacheKey); found && time.Until(cached.ExpiresAt) > minimumTokenLifetime {
+			auth.Token, auth.ExpiresAt = cached.Token, cached.ExpiresAt
+			Log.Debug(ctx, "using cached token", "clientId", auth.ClientId, "expiresAt", auth.ExpiresAt)
+			return nil
+		}
+		if err := auth.login(ctx, client); err != nil {
+			return err
+		}
+		if err := auth.Cache.store(cacheKey, cachedToken{Token: auth.Token, ExpiresAt: auth.ExpiresAt}); err != nil {
+			Log.Warn(ctx, "cannot store token in cache", "error", err.Error())
+		}
+		return nil
+	}
+
+	return auth.login(ctx, client)
+}
+
+// reject forgets the token of header, also in the cache, unless a new one was obtained in the meantime.
+func (auth *clientSecretAuthorization) reject(ctx context.Context, client HttpClient, header string) {
+	token, _ := strings.CutPrefix(header, "Bearer ")
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+	if auth.Token == token {
+		auth.Token, auth.ExpiresAt = "", time.Time{}
+	}
+	if auth.Cache != nil {
+		auth.Cache.drop(ctx, tokenCacheKey(client.RootUrl, auth.ClientId), token)
+	}
+}
+
+func (auth *clientSecretAuthorization) login(ctx context.Context, client HttpClient) (err error) {
+	auth.Token, auth.ExpiresAt, err = Login(ctx, client, auth.LoginApiPath, auth.ClientId, auth.ClientSecret)
+	return err
+}
+
+// Login exchanges the given client credentials for an access token at loginApiPath, without any caching.
+func Login(ctx context.Context, client HttpClient, loginApiPath, clientId, clientSecret string) (token string, expiresAt time.Time, err error) {
+	loginApiUrl := client.RootUrl.JoinPath(loginApiPath)
 
 	type loginRequest struct {
 		ClientId     string `json:"clientId"`
 		ClientSecret string `json:"clientSecret"`
 	}
 
-	type loginResponse struct {
-		Token     string `json:"access_token"`
-		ExpireSec int    `json:"expires_in"`
+	loginResult, err := DoRequest[loginResponse](ctx, client, http.MethodPost, loginApiUrl,
+		withPayload(loginRequest{ClientId: clientId, ClientSecret: clientSecret}, "application/json"),
+	)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("login at %s with client id '%s' failed: %w", loginApiUrl, clientId, err)
 	}
+	expiresAt = loginResult.expiresAt()
+	Log.Debug(ctx, "login successful", "url", loginApiUrl, "clientId", clientId, "expiresAt", expiresAt)
+	return loginResult.Token, expiresAt, nil
+}
 
-	loginResult, err := DoRequest[loginResponse](ctx, client, http.MethodPost, loginApiUrl,
-		withPayload(loginRequest{ClientId: auth.ClientId, ClientSecret: auth.ClientSecret}, "application/json"),
+type oidcAuthorization struct {
+	BearerTokenAuthorization
+	TokenApiPath string
+	ClientId     string
+	Audience     string
+	IdToken      IdTokenSource
+	ExpiresAt    time.Time
+	mu           sync.Mutex
+}
+
+func (auth *oidcAuthorization) Header(ctx context.Context, client HttpClient) (string, error) {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+	if err := auth.ensureValidToken(ctx, client); err != nil {
+		return "", err
+	}
+	return auth.BearerTokenAuthorization.Header(ctx, client)
+}
+
+// ensureValidToken works like clientSecretAuthorization.ensureValidToken, but presents the
+// federated JWT instead of a client secret.
+func (auth *oidcAuthorization) ensureValidToken(ctx context.Context, client HttpClient) error {
+	if auth.Token != "" && time.Until(auth.ExpiresAt) > minimumTokenLifetime {
+		return nil
+	}
+
+	idToken, err := auth.IdToken()
+	if err != nil {
+		return fmt.Errorf("cannot read OIDC token for client id '%s': %w", auth.ClientId, err)
+	}
+	idToken = strings.TrimSpace(idToken)
+	if idToken == "" {
+		return fmt.Errorf("cannot read OIDC token for client id '%s': token is empty", auth.ClientId)
+	}
+
+	tokenApiUrl := client.RootUrl.JoinPath(auth.TokenApiPath)
+
+	type tokenExchangeRequest struct {
+		ClientId string `json:"clientId"`
+		Audience string `json:"audience,omitempty"`
+		IdToken  string `json:"idToken"`
+	}
+
+	loginResult, err := DoRequest[loginResponse](ctx, client, http.MethodPost, tokenApiUrl,
+		withPayload(tokenExchangeRequest{ClientId: auth.ClientId, Audience: auth.Audience, IdToken: idToken}, "application/json"),
 	)
 	if err != nil {
-		return fmt.Errorf("login at %s with client id '%s' failed: %w", loginApiUrl, auth.ClientId, err)
+		return fmt.Errorf("OIDC token exchange at %s with client id '%s' failed: %w", tokenApiUrl, auth.ClientId, err)
 	}
-	auth.Token = loginResult.Token
-	auth.ExpiresAt = time.Now().Add(time.Duration(loginResult.ExpireSec) * time.Second)
-	Log.Debug(ctx, "login successful", "url", loginApiUrl, "clientId", auth.ClientId, "expiresAt", auth.ExpiresAt)
+	auth.Token, auth.ExpiresAt = loginResult.Token, loginResult.expiresAt()
+	Log.Debug(ctx, "OIDC token exchange successful", "url", tokenApiUrl, "clientId", auth.ClientId, "expiresAt", auth.ExpiresAt)
 	return nil
 }
+
+// loginResponse is the access token response shared by the login and OIDC token exchange endpoints.
+type loginResponse struct {
+	Token     string `json:"access_token"`
+	ExpireSec int    `json:"expires_in"`
+}
+
+func (r loginResponse) expiresAt() time.Time {
+	return time.Now().Add(time.Duration(r.ExpireSec) * time.Second)
+}
@@ -0,0 +1,289 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects whether WithCassette records real traffic or replays it.
+type CassetteMode string
+
+const (
+	CassetteRecord CassetteMode = "record"
+	CassetteReplay CassetteMode = "replay"
+)
+
+// CassetteOptions configure WithCassette.
+type CassetteOptions struct {
+	Mode CassetteMode
+	// Path of the JSON cassette file.
+	Path string
+}
+
+// WithCassette sets up the given client to record its request/response pairs to a cassette file,
+// or to replay them from it without any network access. Requests are matched by method, path, query
+// and normalized body, see cassetteRequest.Matches.
+//
+// Credentials never end up in the cassette: the Authorization header and the JSON fields listed in
+// redactedFields are replaced by a placeholder, both when recording and before matching.
+// Cassettes are shared per path within the process, so that a test creating many clients records
+// and replays a single sequence of interactions.
+func WithCassette(c HttpClient, options CassetteOptions) (HttpClient, error) {
+	next := http.DefaultTransport
+	if c.Transport != nil {
+		next = c.Transport
+	}
+	switch options.Mode {
+	case CassetteRecord, CassetteReplay:
+	default:
+		return c, fmt.Errorf("unknown cassette mode '%s', must be '%s' or '%s'", options.Mode, CassetteRecord, CassetteReplay)
+	}
+	entry, _ := cassettes.LoadOrStore(options.Path, &cassette{Path: options.Path, Mode: options.Mode})
+	cassette := entry.(*cassette) //nolint:forcetypeassert // only cassette is stored
+	if err := cassette.init(options.Mode); err != nil {
+		return c, err
+	}
+	c.Transport = &cassetteRoundTripper{Next: next, Cassette: cassette}
+	return c, nil
+}
+
+var cassettes sync.Map
+
+type cassette struct {
+	Path string       `json:"-"`
+	Mode CassetteMode `json:"-"`
+
+	initOnce sync.Once
+	initErr  error
+
+	mu           sync.Mutex
+	Interactions []*cassetteInteraction `json:"interactions"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+	replayed bool
+}
+
+type cassetteRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	cassetteBody
+}
+
+type cassetteResponse struct {
+	StatusCode int         `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	cassetteBody
+}
+
+// cassetteBody keeps JSON bodies as (redacted, normalized) JSON for readable cassettes and anything else as text.
+type cassetteBody struct {
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+func (c *cassette) init(mode CassetteMode) error {
+	c.initOnce.Do(func() {
+		if mode == CassetteRecord {
+			// Start with an empty cassette, which is written with the first interaction.
+			return
+		}
+		data, err := os.ReadFile(c.Path)
+		if errors.Is(err, fs.ErrNotExist) {
+			c.initErr = fmt.Errorf("cassette %s not found, record it first", c.Path)
+			return
+		} else if err != nil {
+			c.initErr = fmt.Errorf("cannot read cassette %s: %w", c.Path, err)
+			return
+		}
+		if err := json.Unmarshal(data, c); err != nil {
+			c.initErr = fmt.Errorf("cannot parse cassette %s: %w", c.Path, err)
+			return
+		}
+		// The file is indented (or even edited by hand), normalize it again for matching.
+		for _, interaction := range c.Interactions {
+			if len(interaction.Request.Body) > 0 {
+				interaction.Request.cassetteBody = newCassetteBody(interaction.Request.Body)
+			}
+		}
+	})
+	if c.initErr == nil && c.Mode != mode {
+		return fmt.Errorf("cassette %s is already used in %s mode", c.Path, c.Mode)
+	}
+	return c.initErr
+}
+
+// record appends the interaction and writes the whole cassette, so that it is complete whenever the process ends.
+func (c *cassette) record(interaction *cassetteInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, append(data, '\n'), 0o600)
+}
+
+// replay returns the first matching interaction not replayed yet. Once all matching interactions were
+// replayed, the last one is repeated, as the number of e.g. refreshing GET requests may vary.
+func (c *cassette) replay(request cassetteRequest) *cassetteInteraction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var lastMatch *cassetteInteraction
+	for _, interaction := range c.Interactions {
+		if !interaction.Request.Matches(request) {
+			continue
+		}
+		if !interaction.replayed {
+			interaction.replayed = true
+			return interaction
+		}
+		lastMatch = interaction
+	}
+	return lastMatch
+}
+
+func (r cassetteRequest) Matches(other cassetteRequest) bool {
+	return r.Method == other.Method && r.Path == other.Path && r.Query == other.Query &&
+		bytes.Equal(r.Body, other.Body) && r.Text == other.Text
+}
+
+type cassetteRoundTripper struct {
+	Next     http.RoundTripper
+	Cassette *cassette
+}
+
+func (r *cassetteRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readCassetteRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		// RoundTrip must not modify the given request, so the body is replaced on a copy.
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	request := cassetteRequest{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		Query:        req.URL.Query().Encode(), // sorted by key
+		Headers:      redactedCassetteHeaders(req.Header),
+		cassetteBody: newCassetteBody(requestBody),
+	}
+	if r.Cassette.Mode == CassetteReplay {
+		interaction := r.Cassette.replay(request)
+		if interaction == nil {
+			return nil, fmt.Errorf("no interaction for %s %s recorded in cassette %s", req.Method, req.URL.RequestURI(), r.Cassette.Path)
+		}
+		return interaction.Response.toResponse(req), nil
+	}
+
+	resp, err := r.Next.RoundTrip(req)
+	if err != nil {
+		// Connection errors are not recorded, the replaying client would see them as missing interaction.
+		return resp, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read response body for cassette: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	interaction := &cassetteInteraction{
+		Request: request,
+		Response: cassetteResponse{
+			StatusCode:   resp.StatusCode,
+			Headers:      redactedCassetteHeaders(resp.Header),
+			cassetteBody: newCassetteBody(responseBody),
+		},
+	}
+	if err := r.Cassette.record(interaction); err != nil {
+		return nil, fmt.Errorf("cannot write cassette %s: %w", r.Cassette.Path, err)
+	}
+	return resp, nil
+}
+
+func readCassetteRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer func() {
+		_ = req.Body.Close()
+	}()
+	requestBody, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read request body for cassette: %w", err)
+	}
+	return requestBody, nil
+}
+
+func (r cassetteResponse) toResponse(req *http.Request) *http.Response {
+	body := []byte(r.Text)
+	if len(r.Body) > 0 {
+		body = r.Body
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Headers.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// newCassetteBody normalizes a JSON body by redacting secrets and re-encoding it (with sorted keys),
+// so that bodies differing only in key order or secret values match.
+func newCassetteBody(data []byte) cassetteBody {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return cassetteBody{}
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return cassetteBody{Text: string(data)}
+	}
+	normalized, err := json.Marshal(redactSecrets(decoded))
+	if err != nil {
+		return cassetteBody{Text: string(data)}
+	}
+	return cassetteBody{Body: normalized}
+}
+
+// redactedCassetteHeaders drops headers varying between runs and redacts credentials, like loggedHeaders.
+func redactedCassetteHeaders(header http.Header) http.Header {
+	result := http.Header{}
+	for key, values := range header {
+		switch {
+		case key == "Authorization" || key == "Cookie" || key == "Set-Cookie":
+			result[key] = []string{redacted}
+		case key == "Date" || key == "User-Agent" || strings.HasPrefix(key, "X-Request"):
+		default:
+			result[key] = slices.Clone(values)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
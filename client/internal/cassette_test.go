@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCassette(t *testing.T) {
+	rootUrl, err := url.Parse("http://meshstack.invalid")
+	require.NoError(t, err)
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	newClient := func(t *testing.T, mode CassetteMode, next roundTripperFunc) HttpClient {
+		t.Helper()
+		t.Cleanup(func() { cassettes.Delete(cassettePath) })
+		client, err := WithCassette(HttpClient{Client: &http.Client{Transport: next}, RootUrl: rootUrl}, CassetteOptions{Mode: mode, Path: cassettePath})
+		require.NoError(t, err)
+		return client
+	}
+	doRequest := func(t *testing.T, client HttpClient, method, pathAndQuery, body string) (string, error) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), method, rootUrl.String()+pathAndQuery, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer some-token")
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		responseBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(responseBody), nil
+	}
+
+	t.Run("record", func(t *testing.T) {
+		client := newClient(t, CassetteRecord, func(req *http.Request) (*http.Response, error) {
+			var body string
+			switch req.URL.Path {
+			case "/api/login":
+				body = `{"access_token":"some-access-token","expires_in":3600}`
+			case "/api/meshobjects/meshintegrations":
+				body = `{"metadata":{"uuid":"some-uuid"}}`
+			}
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body))}, nil
+		})
+		responseBody, err := doRequest(t, client, http.MethodPost, "/api/login", `{"clientId":"some-client","clientSecret":"some-client-secret"}`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"access_token":"some-access-token","expires_in":3600}`, responseBody, "the recording client sees the real response")
+		_, err = doRequest(t, client, http.MethodPost, "/api/meshobjects/meshintegrations", `{"spec":{"config":{"secret":{"plaintext":"some-plaintext"}},"name":"integration"}}`)
+		require.NoError(t, err)
+		_, err = doRequest(t, client, http.MethodGet, "/api/meshobjects/meshintegrations?workspace=a&page=0", "")
+		require.NoError(t, err)
+
+		cassette, err := os.ReadFile(cassettePath)
+		require.NoError(t, err)
+		for _, secret := range []string{"some-token", "some-access-token", "some-client-secret", "some-plaintext"} {
+			assert.NotContains(t, string(cassette), secret)
+		}
+		assert.Contains(t, string(cassette), `"Authorization": [`+"\n"+`            "[REDACTED]"`)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		client := newClient(t, CassetteReplay, func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("replay must not hit the network")
+		})
+		responseBody, err := doRequest(t, client, http.MethodPost, "/api/login", `{"clientSecret":"other-client-secret","clientId":"some-client"}`)
+		require.NoError(t, err, "body matched regardless of key order and secret values")
+		assert.JSONEq(t, `{"access_token":"[REDACTED]","expires_in":3600}`, responseBody)
+
+		responseBody, err = doRequest(t, client, http.MethodPost, "/api/meshobjects/meshintegrations", `{"spec":{"name":"integration","config":{"secret":{"plaintext":"other"}}}}`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"metadata":{"uuid":"some-uuid"}}`, responseBody)
+
+		_, err = doRequest(t, client, http.MethodGet, "/api/meshobjects/meshintegrations?page=0&workspace=a", "")
+		require.NoError(t, err, "query matched regardless of parameter order")
+
+		_, err = doRequest(t, client, http.MethodGet, "/api/meshobjects/meshintegrations?page=1&workspace=a", "")
+		assert.ErrorContains(t, err, "no interaction for GET /api/meshobjects/meshintegrations?page=1&workspace=a recorded in cassette")
+	})
+
+	t.Run("replay without cassette", func(t *testing.T) {
+		cassettePath := filepath.Join(t.TempDir(), "missing.json")
+		t.Cleanup(func() { cassettes.Delete(cassettePath) })
+		_, err := WithCassette(HttpClient{Client: &http.Client{}, RootUrl: rootUrl}, CassetteOptions{Mode: CassetteReplay, Path: cassettePath})
+		assert.ErrorContains(t, err, "not found, record it first")
+	})
+}
@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// CircuitBreakerOptions configure the circuit breaker shared by all clients of a meshStack endpoint,
+// see RetryOptions.CircuitBreaker.
+//
+// Once the backend fails FailureThreshold consecutive times, the circuit opens: instead of every
+// request retrying on its own, all requests wait while a single probe checks whether the backend is
+// available again, and are then released together.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures (502, 503, 504 or connection errors)
+	// which open the circuit. If zero, no circuit breaker is used.
+	FailureThreshold int
+	// ProbePath is requested with GET (unauthenticated) to check whether the backend is available again.
+	ProbePath string
+	// ProbeBackoff calculates the wait before each probe.
+	ProbeBackoff RetryBackoff
+	// MaxWait limits how long requests wait for the backend to become available again before they fail
+	// with BackendUnavailableError.
+	MaxWait time.Duration
+}
+
+// BackendUnavailableError is returned for a request which waited in vain for the backend to recover.
+type BackendUnavailableError struct {
+	Waited time.Duration
+}
+
+func (e BackendUnavailableError) Error() string {
+	return fmt.Sprintf("backend unavailable, waited %s", e.Waited.Round(time.Second))
+}
+
+// circuitBreakers holds one circuitBreaker per endpoint and options for the whole process, so that all
+// provider instances talking to the same meshStack back off together.
+var circuitBreakers sync.Map
+
+type circuitBreakerKey struct {
+	rootUrl string
+	options CircuitBreakerOptions
+}
+
+// sharedCircuitBreaker returns the circuitBreaker of rootUrl with the given options. Only the state of the
+// backend is shared: each client sends its requests and probes through its own transport, e.g. with its own
+// CA bundle or proxy, see Record.
+func sharedCircuitBreaker(rootUrl *url.URL, options CircuitBreakerOptions) *circuitBreaker {
+	if options.FailureThreshold <= 0 {
+		return nil
+	}
+	breaker, _ := circuitBreakers.LoadOrStore(circuitBreakerKey{rootUrl.String(), options}, &circuitBreaker{
+		CircuitBreakerOptions: options,
+		ProbeUrl:              rootUrl.JoinPath(options.ProbePath),
+	})
+	return breaker.(*circuitBreaker) //nolint:forcetypeassert // only circuitBreaker is stored
+}
+
+type circuitBreaker struct {
+	CircuitBreakerOptions
+	ProbeUrl *url.URL
+
+	mu       sync.Mutex
+	failures int
+	// outage is nil while the circuit is closed.
+	outage *outage
+}
+
+type outage struct {
+	// done is closed when the outage is over, after setting available.
+	done      chan struct{}
+	since     time.Time
+	available bool
+}
+
+// Wait blocks while the circuit is open. It fails with BackendUnavailableError if the backend does not
+// recover within MaxWait. A nil circuitBreaker never blocks.
+func (b *circuitBreaker) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	o := b.outage
+	b.mu.Unlock()
+	if o == nil {
+		return nil
+	}
+	waitStart := timeNow()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-o.done:
+	}
+	if !o.available {
+		return BackendUnavailableError{Waited: timeNow().Sub(waitStart)}
+	}
+	return nil
+}
+
+// Record counts consecutive failures of the backend and opens the circuit once FailureThreshold is
+// reached. The backend is then probed through next, the transport of the failed request. A nil
+// circuitBreaker records nothing.
+func (b *circuitBreaker) Record(ctx context.Context, next http.RoundTripper, resp *http.Response, err error) {
+	if b == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		default:
+			b.failures = 0
+			return
+		}
+	}
+	b.failures++
+	if b.failures < b.FailureThreshold || b.outage != nil {
+		return
+	}
+	b.outage = &outage{done: make(chan struct{}), since: timeNow()}
+	Log.Warn(ctx, "backend unavailable, pausing requests until it recovers", "consecutiveFailures", b.failures, "probeUrl", b.ProbeUrl.String())
+	go b.probe(context.WithoutCancel(ctx), next, b.outage)
+}
+
+// probe checks the backend until it is available again or MaxWait has passed, then closes the circuit
+// and releases all waiting requests.
+func (b *circuitBreaker) probe(ctx context.Context, next http.RoundTripper, o *outage) {
+	defer func() {
+		b.mu.Lock()
+		b.outage = nil
+		b.failures = 0
+		b.mu.Unlock()
+		close(o.done)
+	}()
+	for attempt := 1; ; attempt++ {
+		waitTime := b.ProbeBackoff.Calculate(attempt)
+		if timeNow().Add(waitTime).Sub(o.since) > b.MaxWait {
+			Log.Warn(ctx, "backend still unavailable, giving up", "waited", timeNow().Sub(o.since))
+			return
+		}
+		time.Sleep(waitTime)
+		if b.probeOnce(ctx, next) {
+			o.available = true
+			Log.Info(ctx, "backend available again, resuming requests", "waited", timeNow().Sub(o.since))
+			return
+		}
+	}
+}
+
+func (b *circuitBreaker) probeOnce(ctx context.Context, next http.RoundTripper) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.ProbeUrl.String(), nil)
+	if err != nil {
+		return false
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		Log.Debug(ctx, "backend probe failed", "error", err.Error())
+		return false
+	}
+	drainAndCloseResponseBody(ctx, resp)
+	Log.Debug(ctx, "backend probe", "status", resp.StatusCode)
+	return resp.StatusCode >= 200 && resp.StatusCode <= 299
+}
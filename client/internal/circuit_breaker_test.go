@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetryCircuitBreaker(t *testing.T) {
+	// newClient returns a client talking to a backend which is unavailable until recoversAfter,
+	// counting the requests hitting the backend through its transport. Each test uses its own root URL,
+	// as circuit breakers are shared per endpoint.
+	newClient := func(t *testing.T, rootUrl string, recoversAfter time.Duration) (client HttpClient, requests, probes func() int) {
+		t.Helper()
+		parsedRootUrl, err := url.Parse(rootUrl)
+		require.NoError(t, err)
+		t.Cleanup(func() { deleteCircuitBreakers(parsedRootUrl) })
+		start := time.Now()
+		var mu sync.Mutex
+		requestCount, probeCount := 0, 0
+		client = HttpClient{
+			Client: &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				if req.URL.Path == "/probe" {
+					probeCount++
+				} else {
+					requestCount++
+				}
+				mu.Unlock()
+				if time.Since(start) < recoversAfter {
+					return &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+				}
+				return okResponse(req)
+			})},
+			RootUrl: parsedRootUrl,
+		}
+		client = WithRetry(client, RetryOptions{
+			MaxRetries: 100,
+			Backoff:    ExponentialBackoff{MinWait: 1 * time.Second, MaxWait: 1 * time.Second},
+			CircuitBreaker: CircuitBreakerOptions{
+				FailureThreshold: 3,
+				ProbePath:        "/probe",
+				ProbeBackoff:     ExponentialBackoff{MinWait: 1 * time.Second, MaxWait: 4 * time.Second},
+				MaxWait:          10 * time.Second,
+			},
+		})
+		return client, func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return requestCount
+			}, func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return probeCount
+			}
+	}
+
+	doConcurrently := func(t *testing.T, client HttpClient, n int) (finishedAfter []time.Duration, errs []error) {
+		t.Helper()
+		start := time.Now()
+		finishedAfter, errs = make([]time.Duration, n), make([]error, n)
+		var wg sync.WaitGroup
+		for i := range n {
+			wg.Go(func() {
+				req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, client.RootUrl.JoinPath("/get").String(), nil)
+				require.NoError(t, err)
+				resp, err := client.Do(req)
+				if err == nil {
+					_ = resp.Body.Close()
+				}
+				finishedAfter[i], errs[i] = time.Since(start), err
+			})
+		}
+		wg.Wait()
+		return
+	}
+
+	t.Run("requests wait for a single probe and are released together", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			client, requests, probes := newClient(t, "http://breaker-recovers.invalid", 5*time.Second)
+			finishedAfter, errs := doConcurrently(t, client, 3)
+			for i := range errs {
+				assert.NoError(t, errs[i])
+				// Probes at 1s, 3s and 7s, the last one succeeds.
+				assert.Equal(t, 7*time.Second, finishedAfter[i])
+			}
+			assert.Equal(t, 3, probes())
+			assert.Equal(t, 6, requests(), "each request fails once, then waits until the probe succeeds")
+		})
+	})
+
+	t.Run("clients share the outage, but not their transport", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			// The circuit breaker is created with the client created first.
+			other, otherRequests, otherProbes := newClient(t, "http://breaker-shared.invalid", 0)
+			failing, failingRequests, failingProbes := newClient(t, "http://breaker-shared.invalid", 5*time.Second)
+			go func() { _, _ = doConcurrently(t, failing, 1) }()
+			// The circuit opens with the third failure at 2s, and the probes at 3s and 5s go through the
+			// transport of the failing client.
+			time.Sleep(2500 * time.Millisecond)
+			finishedAfter, errs := doConcurrently(t, other, 1)
+			require.NoError(t, errs[0])
+			assert.Equal(t, 2500*time.Millisecond, finishedAfter[0])
+			synctest.Wait()
+			assert.Equal(t, 2, failingProbes())
+			assert.Equal(t, 4, failingRequests())
+			assert.Equal(t, 0, otherProbes())
+			assert.Equal(t, 1, otherRequests())
+		})
+	})
+
+	t.Run("requests fail once the backend does not recover within MaxWait", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			client, requests, probes := newClient(t, "http://breaker-unavailable.invalid", time.Hour)
+			_, errs := doConcurrently(t, client, 3)
+			for _, err := range errs {
+				var unavailable BackendUnavailableError
+				require.ErrorAs(t, err, &unavailable)
+				// Requests start waiting after their first retry backoff at 1s, the probe gives up after 7s.
+				assert.ErrorContains(t, err, "backend unavailable, waited 6s")
+			}
+			assert.Equal(t, 3, probes())
+			assert.Equal(t, 3, requests())
+		})
+	})
+}
+
+func TestSharedCircuitBreaker(t *testing.T) {
+	rootUrl, err := url.Parse("http://breaker-options.invalid")
+	require.NoError(t, err)
+	t.Cleanup(func() { deleteCircuitBreakers(rootUrl) })
+	options := CircuitBreakerOptions{FailureThreshold: 3, ProbePath: "/probe", ProbeBackoff: ExponentialBackoff{MinWait: time.Second}, MaxWait: time.Minute}
+
+	breaker := sharedCircuitBreaker(rootUrl, options)
+	assert.Same(t, breaker, sharedCircuitBreaker(rootUrl, options))
+	options.MaxWait = time.Hour
+	assert.NotSame(t, breaker, sharedCircuitBreaker(rootUrl, options), "other options get their own circuit breaker")
+	assert.Nil(t, sharedCircuitBreaker(rootUrl, CircuitBreakerOptions{}))
+}
+
+// deleteCircuitBreakers deletes the circuit breakers shared for rootUrl, so that a test starts without an outage.
+func deleteCircuitBreakers(rootUrl *url.URL) {
+	circuitBreakers.Range(func(key, _ any) bool {
+		if key.(circuitBreakerKey).rootUrl == rootUrl.String() { //nolint:forcetypeassert // only circuitBreakerKey is stored
+			circuitBreakers.Delete(key)
+		}
+		return true
+	})
+}
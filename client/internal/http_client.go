@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,7 +16,7 @@ import (
 
 // NewHttpClient creates a new client with an underlying http.Client being a pointer to be modified by WithRetry.
 func NewHttpClient(rootUrl *url.URL, userAgent string, auth Authorization) HttpClient {
-	return HttpClient{&http.Client{Timeout: 5 * time.Minute}, rootUrl, userAgent, auth}
+	return HttpClient{Client: &http.Client{Timeout: 5 * time.Minute}, RootUrl: rootUrl, UserAgent: userAgent, Authorization: auth}
 }
 
 // HttpClient wraps [http.Client] with convenient request handling thanks to RequestOption.
@@ -24,6 +25,16 @@ type HttpClient struct {
 	RootUrl       *url.URL
 	UserAgent     string
 	Authorization Authorization
+	// ReadCache is optional, nil disables it.
+	ReadCache *ReadCache
+	// PinnedApiVersions override the API version negotiation by meshObject kind, see negotiateApiVersion.
+	PinnedApiVersions map[string]string
+	// SkipApiVersionNegotiation uses the oldest supported API version of kinds not pinned, without asking
+	// meshStack, see negotiateApiVersion.
+	SkipApiVersionNegotiation bool
+	// ListPageSize is the number of meshObjects per page requested by MeshObjectClient.List, see
+	// WithPageSize. Zero leaves it to the backend's default.
+	ListPageSize int
 }
 
 func DoAuthorizedRequest[R any](ctx context.Context, c HttpClient, method string, url *url.URL, options ...RequestOption) (result R, err error) {
@@ -34,7 +45,14 @@ func DoAuthorizedRequest[R any](ctx context.Context, c HttpClient, method string
 	if err != nil {
 		return result, err
 	}
-	return DoRequest[R](ctx, c, method, url, append(options, withHeader("Authorization", authHeader))...)
+	result, err = DoRequest[R](ctx, c, method, url, append(options, withHeader("Authorization", authHeader))...)
+	if httpErr, ok := errors.AsType[HttpError](err); ok && httpErr.IsUnauthorized() {
+		if auth, ok := c.Authorization.(rejectableAuthorization); ok {
+			Log.Debug(ctx, "access token rejected, obtaining a new one with the next request")
+			auth.reject(ctx, c, authHeader)
+		}
+	}
+	return result, err
 }
 
 func DoRequest[R any](ctx context.Context, c HttpClient, method string, url *url.URL, options ...RequestOption) (result R, err error) {
@@ -58,7 +76,7 @@ func DoRequest[R any](ctx context.Context, c HttpClient, method string, url *url
 	return
 }
 
-func (c HttpClient) doRequest(ctx context.Context, method string, url *url.URL, options []RequestOption) ([]byte, error) {
+func (c HttpClient) doRequest(ctx context.Context, method string, url *url.URL, options []RequestOption) (body []byte, err error) {
 	options = slices.Insert(options, 0,
 		withHeader("User-Agent", c.UserAgent),
 	)
@@ -69,10 +87,35 @@ func (c HttpClient) doRequest(ctx context.Context, method string, url *url.URL,
 	if opts.optionErr != nil {
 		return nil, opts.optionErr
 	}
+	ctx, span := startRequestSpan(ctx, method, opts.tracedMeshObject.PathTemplate(url.JoinPath(opts.extraPathElems...).Path), opts.tracedMeshObject.Kind)
+	defer func() {
+		if err != nil {
+			span.SetError(err)
+		}
+		span.End()
+	}()
+	if opts.attempts != nil {
+		ctx = context.WithValue(ctx, attemptsContextKey{}, opts.attempts)
+	}
 	req, err := c.buildRequest(ctx, method, *url, opts)
 	if err != nil {
 		return nil, err
 	}
+	kind := opts.tracedMeshObject.Kind
+	switch {
+	case c.ReadCache == nil || kind == "":
+	case method != http.MethodGet:
+		// Also invalidated on errors, as the change might have been applied nevertheless.
+		defer c.ReadCache.Invalidate()
+	case len(opts.responseHandlers) == 0: // response handlers need the response itself, e.g. its ETag
+		return c.ReadCache.Get(ctx, newReadCacheKey(kind, req), func(ctx context.Context) ([]byte, error) {
+			return c.sendRequest(ctx, req.WithContext(ctx), opts)
+		})
+	}
+	return c.sendRequest(ctx, req, opts)
+}
+
+func (c HttpClient) sendRequest(ctx context.Context, req *http.Request, opts requestOptions) ([]byte, error) {
 	res, err := c.Do(req)
 	if err != nil {
 		return nil, err
@@ -80,6 +123,10 @@ func (c HttpClient) doRequest(ctx context.Context, method string, url *url.URL,
 	defer func() {
 		_ = res.Body.Close()
 	}()
+	requestSpan(ctx).SetAttribute(spanAttributeStatusCode, res.StatusCode)
+	for _, responseHandler := range opts.responseHandlers {
+		responseHandler(res)
+	}
 	return c.readBodyAndCheckSuccess(ctx, res)
 }
 
@@ -94,10 +141,7 @@ func (c HttpClient) readBodyAndCheckSuccess(ctx context.Context, res *http.Respo
 		return responseBody, nil
 	}
 
-	return responseBody, HttpError{
-		StatusCode:   res.StatusCode,
-		ResponseBody: responseBody,
-	}
+	return responseBody, newHttpError(res.StatusCode, responseBody)
 }
 
 func (c HttpClient) buildRequest(ctx context.Context, method string, url url.URL, opts requestOptions) (*http.Request, error) {
@@ -128,6 +172,7 @@ func (c HttpClient) buildRequest(ctx context.Context, method string, url url.URL
 	for _, requestModifier := range opts.requestModifiers {
 		requestModifier(req)
 	}
+	Tracing.Inject(ctx, req.Header)
 	Log.Debug(ctx, "request", "url", req.URL.String(), "method", req.Method, "headers", loggedHeaders(req.Header), "body", loggedBody{requestBody})
 	return req, err
 }
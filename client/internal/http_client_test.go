@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -144,6 +145,17 @@ func TestHttpClient(t *testing.T) {
 		assert.Equal(t, 2, attempts, "DELETE must be retried after a 503")
 	})
 
+	t.Run("DoRequest with If-Match (not retried)", func(t *testing.T) {
+		attempts := 0
+		client := WithRetry(newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			attempts++
+			resp.WriteHeader(502)
+		}), RetryOptions{MaxRetries: 3, Backoff: &retryTestBackoff{}})
+		_, err := DoRequest[any](t.Context(), client, http.MethodPut, client.RootUrl, WithIfMatch(`"v1"`))
+		require.Error(t, err)
+		assert.Equal(t, 1, attempts, "conditional PUT must not be retried")
+	})
+
 	t.Run("DoRequest with PUT replays body on retry", func(t *testing.T) {
 		attempt := 0
 		client := WithRetry(newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
@@ -161,6 +173,33 @@ func TestHttpClient(t *testing.T) {
 		assert.Equal(t, 2, attempt)
 	})
 
+	t.Run("DoRequest with ETag and If-Match", func(t *testing.T) {
+		const currentETag = `"v2"`
+		client := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			if ifMatch := req.Header.Get("If-Match"); ifMatch != "" && ifMatch != currentETag {
+				resp.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			resp.Header().Set("ETag", currentETag)
+			resp.WriteHeader(http.StatusOK)
+			_, _ = resp.Write([]byte(`"some-answer"`))
+		})
+		var etag string
+		_, err := DoRequest[string](t.Context(), client, http.MethodGet, client.RootUrl.JoinPath("get"), WithResponseETag(&etag))
+		require.NoError(t, err)
+		assert.Equal(t, currentETag, etag)
+
+		_, err = DoRequest[string](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("put"), WithIfMatch(etag))
+		require.NoError(t, err)
+		_, err = DoRequest[string](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("put"), WithIfMatch("")) // unconditional
+		require.NoError(t, err)
+
+		_, err = DoRequest[string](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("put"), WithIfMatch(`"v1"`))
+		httpErr, ok := errors.AsType[HttpError](err)
+		require.True(t, ok)
+		assert.True(t, httpErr.IsPreconditionFailed())
+	})
+
 	t.Run("DoAuthorizedRequest with BearerTokenAuthorization", func(t *testing.T) {
 		client := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
 			assert.Equal(t, "Bearer my-static-token", req.Header.Get("Authorization"))
@@ -171,6 +210,45 @@ func TestHttpClient(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("DoAuthorizedRequest with oidcAuthorization", func(t *testing.T) {
+		idToken := "first-jwt"
+		exchanges := 0
+		client := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/login/oidc":
+				exchanges++
+				body, _ := io.ReadAll(req.Body)
+				assert.JSONEq(t, fmt.Sprintf(`{"clientId":"test-client","audience":"meshstack","idToken":"%s"}`, idToken), string(body))
+				resp.WriteHeader(http.StatusOK)
+				// expires_in must be less than minimumTokenLifetime to trigger another exchange on the next call
+				_, _ = fmt.Fprintf(resp, `{"access_token":"token-%d", "expires_in": 10}`, exchanges)
+			case "/edit":
+				assert.Equal(t, fmt.Sprintf("Bearer token-%d", exchanges), req.Header.Get("Authorization"))
+				resp.WriteHeader(http.StatusAccepted)
+			default:
+				t.Fatal("unexpected request", req.URL.Path)
+			}
+		})
+		client.Authorization = NewOidcAuthorization("login/oidc", "test-client", "meshstack", func() (string, error) {
+			return idToken + "\n", nil
+		})
+		_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+		require.NoError(t, err)
+
+		idToken = "rotated-jwt"
+		_, err = DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, exchanges, "expiring token must be exchanged again, reading the rotated JWT")
+
+		t.Run("fails on empty JWT", func(t *testing.T) {
+			client.Authorization = NewOidcAuthorization("login/oidc", "test-client", "", func() (string, error) {
+				return " ", nil
+			})
+			_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+			require.ErrorContains(t, err, "cannot read OIDC token for client id 'test-client': token is empty")
+		})
+	})
+
 	t.Run("DoAuthorizedRequest with clientSecretAuthorization and retries", func(t *testing.T) {
 		t.Run("succeeds after second attempt", func(t *testing.T) {
 			retryTestBackoff := retryTestBackoff{}
@@ -195,7 +273,7 @@ func TestHttpClient(t *testing.T) {
 					t.Fatal("unexpected request", req.URL.Path)
 				}
 			}), RetryOptions{MaxRetries: 2, Backoff: &retryTestBackoff, WhitelistedPaths: map[string][]string{http.MethodPost: {"/login"}}})
-			client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret")
+			client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", nil)
 			resp, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
 			require.NoError(t, err)
 			_ = resp
@@ -246,7 +324,7 @@ func TestHttpClient(t *testing.T) {
 					t.Fatal("unexpected request", req.URL.Path)
 				}
 			}), RetryOptions{MaxRetries: 2, Backoff: &retryTestBackoff, WhitelistedPaths: map[string][]string{http.MethodPost: {"/login"}}})
-			client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret")
+			client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", nil)
 			_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
 			require.NoError(t, err)
 			assert.Equal(t, map[string]int{
@@ -262,7 +340,7 @@ func TestHttpClient(t *testing.T) {
 			client := WithRetry(newTestClientWithServer(t, func(resp http.ResponseWriter, r *http.Request) {
 				resp.WriteHeader(503)
 			}), RetryOptions{MaxRetries: 2, Backoff: &retryTestBackoff, WhitelistedPaths: map[string][]string{http.MethodPost: {"/login"}}})
-			client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret")
+			client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", nil)
 			_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
 			require.ErrorContains(t, err, fmt.Sprintf("login at %s/login with client id 'test-client' failed", client.RootUrl))
 			var httpErr HttpError
@@ -1,19 +1,92 @@
 package internal
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // HttpError represents an HTTP error response with status code.
 // This error is returned when an HTTP request fails with a non-2XX status code.
+//
+// If the response body is in meshStack's error format, Message, ErrorCode and Violations are
+// decoded from it, see newHttpError. They are empty for any other response body.
 type HttpError struct {
 	StatusCode   int
 	ResponseBody []byte
+	Message      string
+	ErrorCode    string
+	Violations   []FieldViolation
+}
+
+// FieldViolation is a validation error meshStack reports for a single field of the request payload.
+type FieldViolation struct {
+	// Field is the path of the offending field in the request payload, e.g. spec.inputs.foo.
+	Field string `json:"field"`
+	// Constraint names the violated constraint, if given.
+	Constraint string `json:"constraint"`
+	Message    string `json:"message"`
+}
+
+func (v FieldViolation) String() string {
+	if v.Constraint != "" {
+		return fmt.Sprintf("%s: %s (%s)", v.Field, v.Message, v.Constraint)
+	}
+	return fmt.Sprintf("%s: %s", v.Field, v.Message)
+}
+
+// newHttpError decodes meshStack's error response format from responseBody:
+//
+//	{"message": "...", "errorCode": "...", "violations": [{"field": "spec.displayName", "constraint": "NotBlank", "message": "..."}]}
+//
+// The RFC 7807 "detail" is accepted instead of "message", and "errors" instead of "violations".
+func newHttpError(statusCode int, responseBody []byte) HttpError {
+	httpErr := HttpError{StatusCode: statusCode, ResponseBody: responseBody}
+	var decoded struct {
+		Message    string           `json:"message"`
+		Detail     string           `json:"detail"`
+		ErrorCode  string           `json:"errorCode"`
+		Violations []FieldViolation `json:"violations"`
+		Errors     []FieldViolation `json:"errors"`
+	}
+	if err := json.Unmarshal(responseBody, &decoded); err != nil {
+		return httpErr
+	}
+	httpErr.Message = cmp.Or(decoded.Message, decoded.Detail)
+	httpErr.ErrorCode = decoded.ErrorCode
+	for _, violation := range append(decoded.Violations, decoded.Errors...) {
+		if violation.Field != "" && violation.Message != "" {
+			httpErr.Violations = append(httpErr.Violations, violation)
+		}
+	}
+	return httpErr
 }
 
 func (e HttpError) Error() string {
-	return fmt.Sprintf("http error %d, response '%s'", e.StatusCode, string(e.ResponseBody))
+	if e.Message == "" && len(e.Violations) == 0 {
+		return fmt.Sprintf("http error %d, response '%s'", e.StatusCode, string(e.ResponseBody))
+	}
+	var b strings.Builder
+	b.WriteString(e.Headline())
+	for _, violation := range e.Violations {
+		fmt.Fprintf(&b, "\n- %s", violation)
+	}
+	return b.String()
+}
+
+// Headline is Error without the violations, e.g. "http error 400 (VALIDATION_ERROR): invalid payload".
+func (e HttpError) Headline() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "http error %d", e.StatusCode)
+	if e.ErrorCode != "" {
+		fmt.Fprintf(&b, " (%s)", e.ErrorCode)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	return b.String()
 }
 
 // IsForbidden returns true if the error is a 403 Forbidden response.
@@ -26,7 +99,18 @@ func (e HttpError) IsNotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
 
+// IsUnauthorized returns true if the error is a 401 Unauthorized response, e.g. for a revoked access token.
+func (e HttpError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
 // IsConflict returns true if the error is a 409 Conflict response.
 func (e HttpError) IsConflict() bool {
 	return e.StatusCode == http.StatusConflict
 }
+
+// IsPreconditionFailed returns true if the error is a 412 Precondition Failed response,
+// i.e. the object changed since it was read with the ETag given by WithIfMatch.
+func (e HttpError) IsPreconditionFailed() bool {
+	return e.StatusCode == http.StatusPreconditionFailed
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHttpError(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		wantMessage   string
+		wantCode      string
+		wantViolation []FieldViolation
+		wantError     string
+	}{
+		{
+			name:        "meshStack error with violations",
+			body:        `{"message":"Validation failed","errorCode":"VALIDATION_ERROR","violations":[{"field":"spec.displayName","constraint":"NotBlank","message":"must not be blank"},{"field":"spec.inputs.foo","message":"unknown input"}]}`,
+			wantMessage: "Validation failed",
+			wantCode:    "VALIDATION_ERROR",
+			wantViolation: []FieldViolation{
+				{Field: "spec.displayName", Constraint: "NotBlank", Message: "must not be blank"},
+				{Field: "spec.inputs.foo", Message: "unknown input"},
+			},
+			wantError: "http error 400 (VALIDATION_ERROR): Validation failed\n- spec.displayName: must not be blank (NotBlank)\n- spec.inputs.foo: unknown input",
+		},
+		{
+			name:        "RFC 7807 problem with errors",
+			body:        `{"title":"Bad Request","detail":"Invalid workspace","errors":[{"field":"metadata.name","message":"already taken"}]}`,
+			wantMessage: "Invalid workspace",
+			wantViolation: []FieldViolation{
+				{Field: "metadata.name", Message: "already taken"},
+			},
+			wantError: "http error 400: Invalid workspace\n- metadata.name: already taken",
+		},
+		{
+			name:      "unknown JSON format",
+			body:      `{"foo":"bar"}`,
+			wantError: `http error 400, response '{"foo":"bar"}'`,
+		},
+		{
+			name:      "no JSON",
+			body:      `<html>Bad Gateway</html>`,
+			wantError: `http error 400, response '<html>Bad Gateway</html>'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpErr := newHttpError(400, []byte(tt.body))
+			assert.Equal(t, tt.wantMessage, httpErr.Message)
+			assert.Equal(t, tt.wantCode, httpErr.ErrorCode)
+			assert.Equal(t, tt.wantViolation, httpErr.Violations)
+			assert.EqualError(t, httpErr, tt.wantError)
+			assert.Equal(t, tt.body, string(httpErr.ResponseBody))
+		})
+	}
+}
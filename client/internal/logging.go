@@ -46,7 +46,7 @@ func (l loggedHeaders) String() string {
 		for _, v := range l[k] {
 			// Avoid printing that longish JWT Bearer token (which is also a secret)
 			if k == "Authorization" {
-				v = "[REDACTED]"
+				v = redacted
 			}
 			lines = append(lines, fmt.Sprintf("%s=%s", k, v))
 		}
@@ -54,6 +54,33 @@ func (l loggedHeaders) String() string {
 	return strings.Join(lines, "\n")
 }
 
+// redactedFields are JSON object keys whose values are secrets: the plaintext of types.Secret (e.g. of a
+// platform's or integration's credentials), the client secret of an API key, and the credentials exchanged
+// at the login endpoints.
+var redactedFields = []string{"plaintext", "clientSecret", "idToken", "access_token"}
+
+const redacted = "[REDACTED]"
+
+// redactSecrets replaces the values of redactedFields anywhere in the decoded JSON value, in place.
+func redactSecrets(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, elem := range v {
+			if slices.Contains(redactedFields, key) && elem != nil {
+				v[key] = redacted
+			} else {
+				v[key] = redactSecrets(elem)
+			}
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = redactSecrets(elem)
+		}
+	}
+	return value
+}
+
+// loggedBody pretty-prints a JSON body with its secrets redacted, see redactSecrets.
 type loggedBody struct {
 	io.Reader
 }
@@ -75,10 +102,10 @@ func bytesToPrettyJson(data []byte) string {
 	}
 	var decoded any
 	if err := json.Unmarshal(data, &decoded); err == nil {
-		if indented, err := json.MarshalIndent(decoded, "", "  "); err == nil {
+		if indented, err := json.MarshalIndent(redactSecrets(decoded), "", "  "); err == nil {
 			return string(indented)
 		}
 	}
-	// should never happen as we should only transfer JSON in request/responses
+	// should never happen as we should only transfer JSON in request/responses, and cannot be redacted
 	return fmt.Sprintf("<string,len=%d> %s", len(data), string(data))
 }
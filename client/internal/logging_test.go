@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggedBody(t *testing.T) {
+	t.Run("redacts secrets", func(t *testing.T) {
+		body := loggedBody{bytes.NewBufferString(`{
+			"clientId": "my-key", "clientSecret": "s3cr3t",
+			"spec": {
+				"config": {"aws": {"secretKey": {"plaintext": "aws-s3cr3t"}, "accessKey": "AKIA"}},
+				"inputs": [{"key": "token", "value": {"plaintext": "glpat-s3cr3t", "hash": "sha256:abc"}}],
+				"unset": {"plaintext": null}
+			}
+		}`)}.String()
+		assert.NotContains(t, body, "s3cr3t")
+		assert.JSONEq(t, `{
+			"clientId": "my-key", "clientSecret": "[REDACTED]",
+			"spec": {
+				"config": {"aws": {"secretKey": {"plaintext": "[REDACTED]"}, "accessKey": "AKIA"}},
+				"inputs": [{"key": "token", "value": {"plaintext": "[REDACTED]", "hash": "sha256:abc"}}],
+				"unset": {"plaintext": null}
+			}
+		}`, body)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, "<empty>", loggedBody{&bytes.Buffer{}}.String())
+		assert.Equal(t, "<empty>", loggedBody{}.String())
+	})
+}
+
+func TestLoggedHeaders(t *testing.T) {
+	headers := loggedHeaders(http.Header{"Authorization": {"Bearer eyJ"}, "Accept": {"application/json"}})
+	assert.Equal(t, "Accept=application/json\nAuthorization=[REDACTED]", headers.String())
+}
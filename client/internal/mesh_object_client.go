@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
 	"strings"
+	"sync/atomic"
+	"time"
 	"unicode"
 )
 
@@ -21,16 +24,18 @@ import (
 // which are embedded in HttpClient for convenient construction with NewMeshObjectClient.
 type MeshObjectClient[M any] struct {
 	HttpClient
-	Kind       string
-	ApiVersion string
-	ApiUrl     *url.URL
+	Kind   string
+	ApiUrl *url.URL
+	// apiVersion is shared by the copies of the client, so that the API version is negotiated once.
+	apiVersion *apiVersionNegotiation
 }
 
 // NewMeshObjectClient creates a new [MeshObjectClient] for a specific meshObject type with automatic URL path inference.
-// The meshObject kind is inferred from type M. T
+// The meshObject kind is inferred from type M. The API version is picked from apiVersions, which lists
+// the versions the client supports, the newest first, with the first request, see ApiVersion.
 // The API URL is constructed from explicitApiPathElems if provided,
 // otherwise the pluralized and lowercased kind is used as a single element.
-func NewMeshObjectClient[M any](ctx context.Context, httpClient HttpClient, apiVersion string, explicitApiPathElems ...string) MeshObjectClient[M] {
+func NewMeshObjectClient[M any](ctx context.Context, httpClient HttpClient, apiVersions []string, explicitApiPathElems ...string) MeshObjectClient[M] {
 	kind := InferKind[M]()
 
 	if len(explicitApiPathElems) == 0 {
@@ -38,12 +43,31 @@ func NewMeshObjectClient[M any](ctx context.Context, httpClient HttpClient, apiV
 	}
 	explicitApiPathElems = slices.Insert(explicitApiPathElems, 0, "/api/meshobjects")
 	apiUrl := httpClient.RootUrl.JoinPath(explicitApiPathElems...)
-	Log.Info(ctx, fmt.Sprintf("initialized %s client", reflect.TypeFor[M]().Name()), "url", apiUrl.String(), "kind", kind, "version", apiVersion)
-	return MeshObjectClient[M]{httpClient, kind, apiVersion, apiUrl}
+	Log.Info(ctx, fmt.Sprintf("initialized %s client", reflect.TypeFor[M]().Name()), "url", apiUrl.String(), "kind", kind, "versions", strings.Join(apiVersions, ", "))
+	return MeshObjectClient[M]{httpClient, kind, apiUrl, &apiVersionNegotiation{apiVersions: apiVersions}}
 }
 
 var versionSuffixRe = regexp.MustCompile(`V\d+$`)
 
+var (
+	meshObjectKindRe       = regexp.MustCompile(`^mesh[A-Z][A-Za-z]*$`)
+	meshObjectApiVersionRe = regexp.MustCompile(`^v[0-9]+(-preview)?$`)
+)
+
+// NewMeshObjectClientOfKind creates a new [MeshObjectClient] for a meshObject kind and API version only known
+// at runtime, e.g. from the Terraform configuration, instead of inferring the kind from M and negotiating
+// the version like NewMeshObjectClient. The API URL is the pluralized and lowercased kind.
+func NewMeshObjectClientOfKind[M any](httpClient HttpClient, kind, apiVersion string) (MeshObjectClient[M], error) {
+	if !meshObjectKindRe.MatchString(kind) {
+		return MeshObjectClient[M]{}, fmt.Errorf("invalid meshObject kind '%s', expected e.g. meshProjectRole", kind)
+	}
+	if !meshObjectApiVersionRe.MatchString(apiVersion) {
+		return MeshObjectClient[M]{}, fmt.Errorf("invalid meshObject API version '%s', expected e.g. v1 or v2-preview", apiVersion)
+	}
+	apiUrl := httpClient.RootUrl.JoinPath("/api/meshobjects", strings.ToLower(pluralizeKind(kind)))
+	return MeshObjectClient[M]{httpClient, kind, apiUrl, &apiVersionNegotiation{apiVersion: apiVersion}}, nil
+}
+
 // InferKind infers the meshObject kind from a struct type name using the same convention
 // as the meshObject API: MeshWorkspace → "meshWorkspace", MeshBuildingBlockV2 → "meshBuildingBlock".
 // Version suffixes (V\d+) are stripped.
@@ -69,13 +93,35 @@ func pluralizeKind(kind string) string {
 	return kind + "s"
 }
 
-func (c MeshObjectClient[M]) MeshObjectMimeType() string {
-	return fmt.Sprintf("application/vnd.meshcloud.api.%s.%s.hal+json", c.Kind, c.ApiVersion)
+// ApiVersion returns the API version of the client's meshObject kind. The first call negotiates it,
+// see negotiateApiVersion.
+func (c MeshObjectClient[M]) ApiVersion(ctx context.Context) string {
+	return c.apiVersion.get(ctx, c.HttpClient, c.Kind, c.ApiUrl)
+}
+
+func (c MeshObjectClient[M]) MeshObjectMimeType(ctx context.Context) string {
+	return meshObjectMimeType(c.Kind, c.ApiVersion(ctx))
+}
+
+func meshObjectMimeType(kind, apiVersion string) string {
+	return fmt.Sprintf("application/vnd.meshcloud.api.%s.%s.hal+json", kind, apiVersion)
+}
+
+// WithMeshObjectAccept accepts the meshObject MIME type in the response, and names the request in
+// traces after the meshObject kind. Use it for requests to the meshObject API not sent by this client's
+// methods, e.g. to sub-resources such as .../{id}/logs.
+func (c MeshObjectClient[M]) WithMeshObjectAccept(ctx context.Context) RequestOption {
+	mimeType := c.MeshObjectMimeType(ctx)
+	return func(opts *requestOptions) {
+		WithAccept(mimeType)(opts)
+		withTracedMeshObject(c.Kind, c.ApiUrl.Path)(opts)
+	}
 }
 
 // Get retrieves a meshObject by ID. Returns nil if not found.
-func (c MeshObjectClient[M]) Get(ctx context.Context, id string) (resp *M, err error) {
-	resp, err = DoAuthorizedRequest[*M](ctx, c.HttpClient, http.MethodGet, c.ApiUrl.JoinPath(id), WithAccept(c.MeshObjectMimeType()))
+// Pass WithResponseETag to obtain the ETag for a conditional Put.
+func (c MeshObjectClient[M]) Get(ctx context.Context, id string, options ...RequestOption) (resp *M, err error) {
+	resp, err = DoAuthorizedRequest[*M](ctx, c.HttpClient, http.MethodGet, c.ApiUrl.JoinPath(id), append(options, c.WithMeshObjectAccept(ctx))...)
 	if httpErr, ok := errors.AsType[HttpError](err); ok && httpErr.IsNotFound() {
 		return nil, nil
 	}
@@ -90,14 +136,53 @@ func (c MeshObjectClient[M]) Post(ctx context.Context, payload any, options ...R
 		c.HttpClient,
 		http.MethodPost,
 		c.ApiUrl,
-		append(options, c.withMeshObjectPayload(payload))...,
+		append(options, c.withMeshObjectPayload(ctx, payload))...,
 	)
 }
 
+// PostIdempotently creates a new meshObject like Post, but with an Idempotency-Key, so that the request
+// is retried like PUT or DELETE instead of failing on e.g. a 503.
+//
+// A backend ignoring the Idempotency-Key creates the meshObject for every attempt that reaches it, so
+// a retried request might fail with a 409 conflict although an earlier attempt created the meshObject.
+// The meshObject returned by findOrphan, which looks it up by its natural key, is then adopted instead
+// of leaving it orphaned. findOrphan must only return a meshObject created after createdAfter, the start
+// of the first attempt, so that a meshObject with the same natural key that existed before (e.g. created
+// outside of Terraform) is never adopted. It returns nil if there is none, and the conflict is returned.
+//
+// createdAfter is in meshStack's time, as its createdOn timestamps are: the start of the first attempt
+// is shifted by the difference of the Date header of the conflict response to the time it was received.
+// This is precise to about a second, the resolution of the Date header.
+func (c MeshObjectClient[M]) PostIdempotently(ctx context.Context, payload any, findOrphan func(ctx context.Context, createdAfter time.Time) (*M, error), options ...RequestOption) (*M, error) {
+	var attempts atomic.Int32
+	var date string
+	firstAttempt := timeNow()
+	created, err := c.Post(ctx, payload, append(options, WithIdempotencyKey(NewIdempotencyKey()), withAttempts(&attempts), withResponseHeader("Date", &date))...)
+	received := timeNow()
+	if err == nil || attempts.Load() < 2 {
+		return created, err
+	}
+	if httpErr, ok := errors.AsType[HttpError](err); !ok || !httpErr.IsConflict() {
+		return nil, err
+	}
+	serverTime, dateErr := http.ParseTime(date)
+	if dateErr != nil {
+		Log.Warn(ctx, "conflict response without valid Date header, comparing createdOn with the local clock", "kind", c.Kind, "date", date)
+		serverTime = received
+	}
+	orphan, findErr := findOrphan(WithoutReadCache(ctx), firstAttempt.Add(serverTime.Sub(received)))
+	if findErr != nil || orphan == nil {
+		return nil, err
+	}
+	Log.Warn(ctx, "adopting meshObject created by an earlier attempt of a retried request", "kind", c.Kind, "error", err.Error())
+	return orphan, nil
+}
+
 // Put updates an existing meshObject by ID with the given payload.
 // Automatically injects apiVersion and kind into the JSON payload.
-func (c MeshObjectClient[M]) Put(ctx context.Context, id string, payload any) (*M, error) {
-	return DoAuthorizedRequest[*M](ctx, c.HttpClient, http.MethodPut, c.ApiUrl.JoinPath(id), c.withMeshObjectPayload(payload))
+// Pass WithIfMatch to fail if the meshObject changed since Get.
+func (c MeshObjectClient[M]) Put(ctx context.Context, id string, payload any, options ...RequestOption) (*M, error) {
+	return DoAuthorizedRequest[*M](ctx, c.HttpClient, http.MethodPut, c.ApiUrl.JoinPath(id), append(options, c.withMeshObjectPayload(ctx, payload))...)
 }
 
 // withMeshObjectPayload returns a RequestOption that sets the payload with apiVersion and kind injected,
@@ -106,7 +191,7 @@ func (c MeshObjectClient[M]) Put(ctx context.Context, id string, payload any) (*
 //
 // The double marshal/unmarshal round-trip converts the typed struct to a map[string]any so we can
 // inject the top-level apiVersion and kind fields without coupling the struct type to those fields.
-func (c MeshObjectClient[M]) withMeshObjectPayload(payload any) RequestOption {
+func (c MeshObjectClient[M]) withMeshObjectPayload(ctx context.Context, payload any) RequestOption {
 	intermediate, err := json.Marshal(payload)
 	if err != nil {
 		panic(fmt.Sprintf("failed to marshal %T: %v", payload, err))
@@ -117,47 +202,134 @@ func (c MeshObjectClient[M]) withMeshObjectPayload(payload any) RequestOption {
 		panic(fmt.Sprintf("failed to unmarshal %T to map: %v", payload, err))
 	}
 
-	m["apiVersion"] = c.ApiVersion
+	apiVersion := c.ApiVersion(ctx)
+	m["apiVersion"] = apiVersion
 	m["kind"] = c.Kind
 
-	return withPayload(m, c.MeshObjectMimeType())
+	return func(opts *requestOptions) {
+		withPayload(m, meshObjectMimeType(c.Kind, apiVersion))(opts)
+		withTracedMeshObject(c.Kind, c.ApiUrl.Path)(opts)
+	}
 }
 
 // Delete removes a meshObject by ID.
 func (c MeshObjectClient[M]) Delete(ctx context.Context, id string, options ...RequestOption) (err error) {
-	_, err = DoAuthorizedRequest[any](ctx, c.HttpClient, http.MethodDelete, c.ApiUrl.JoinPath(id), append(options, WithAccept(c.MeshObjectMimeType()))...)
+	_, err = DoAuthorizedRequest[any](ctx, c.HttpClient, http.MethodDelete, c.ApiUrl.JoinPath(id), append(options, c.WithMeshObjectAccept(ctx))...)
 	return
 }
 
-// List retrieves all meshObjects with automatic pagination handling.
-// Accepts optional [RequestOption] parameters for filtering and querying.
+// listPageConcurrency bounds the pages All requests ahead of the consumer.
+const listPageConcurrency = 4
+
+// List retrieves all meshObjects with automatic pagination handling, collecting All.
+// Accepts optional [RequestOption] parameters for filtering and querying. The page size is
+// HttpClient.ListPageSize, unless overridden by WithPageSize.
+// On error, the meshObjects of the pages before the failed page are returned along with it.
 func (c MeshObjectClient[M]) List(ctx context.Context, options ...RequestOption) ([]M, error) {
-	var result []M
-	embeddedKey := pluralizeKind(c.Kind)
-	pageNumber := 0
-
-	for {
-		type paginatedResponse struct {
-			Embedded map[string][]M `json:"_embedded"`
-			Page     struct {
-				TotalPages int `json:"totalPages"`
-				Number     int `json:"number"`
-			} `json:"page"`
+	return Collect(c.All(ctx, options...))
+}
+
+// Collect is slices.Collect for a sequence that stops after yielding an error, such as All. On error, the
+// items yielded before it are returned along with it.
+func Collect[M any](seq iter.Seq2[M, error]) (result []M, err error) {
+	for item, err := range seq {
+		if err != nil {
+			return result, err
 		}
-		response, err := DoAuthorizedRequest[paginatedResponse](ctx, c.HttpClient, http.MethodGet, c.ApiUrl, append(options,
-			WithAccept(c.MeshObjectMimeType()),
-			WithUrlQuery(map[string]any{"page": pageNumber}),
-		)...)
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// All streams all meshObjects with automatic pagination handling, see List. The iteration stops after
+// yielding an error, and the caller may stop it early.
+//
+// The first page tells the total number of pages. The following ones are then fetched concurrently,
+// at most listPageConcurrency pages ahead of the consumer, so that memory stays bounded while the
+// meshObjects are still yielded in page order.
+func (c MeshObjectClient[M]) All(ctx context.Context, options ...RequestOption) iter.Seq2[M, error] {
+	return func(yield func(M, error) bool) {
+		firstPage, totalPages, err := c.listPage(ctx, 0, options)
 		if err != nil {
-			return result, fmt.Errorf("error getting page %d: %w", pageNumber, err)
-		} else if items, ok := response.Embedded[embeddedKey]; !ok {
-			return result, fmt.Errorf("embedded key %s not found in paginated response", embeddedKey)
-		} else {
-			result = append(result, items...)
+			var zero M
+			yield(zero, err)
+			return
+		}
+
+		// Cancels pages fetched ahead once the caller stops early.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		type pageResult struct {
+			items []M
+			err   error
+		}
+		var pending []chan pageResult
+		nextPageNumber := 1
+		fetchNextPage := func() {
+			result := make(chan pageResult, 1)
+			go func(pageNumber int) {
+				items, _, err := c.listPage(ctx, pageNumber, options)
+				result <- pageResult{items, err}
+			}(nextPageNumber)
+			pending = append(pending, result)
+			nextPageNumber++
 		}
-		if response.Page.Number >= response.Page.TotalPages-1 {
-			return result, nil
+		for nextPageNumber < totalPages && len(pending) < listPageConcurrency {
+			fetchNextPage()
 		}
-		pageNumber++
+
+		items := firstPage
+		for {
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(pending) == 0 {
+				return
+			}
+			page := <-pending[0]
+			pending = pending[1:]
+			if page.err != nil {
+				var zero M
+				yield(zero, page.err)
+				return
+			}
+			if nextPageNumber < totalPages {
+				fetchNextPage()
+			}
+			items = page.items
+		}
+	}
+}
+
+// listPage fetches a single page of List.
+func (c MeshObjectClient[M]) listPage(ctx context.Context, pageNumber int, options []RequestOption) (items []M, totalPages int, err error) {
+	type paginatedResponse struct {
+		Embedded map[string][]M `json:"_embedded"`
+		Page     struct {
+			TotalPages int `json:"totalPages"`
+			Number     int `json:"number"`
+		} `json:"page"`
+	}
+	embeddedKey := pluralizeKind(c.Kind)
+	if c.HttpClient.ListPageSize > 0 {
+		// Goes first, so that a WithPageSize among the options takes precedence.
+		options = append([]RequestOption{WithPageSize(c.HttpClient.ListPageSize)}, options...)
+	}
+	response, err := DoAuthorizedRequest[paginatedResponse](ctx, c.HttpClient, http.MethodGet, c.ApiUrl, append(slices.Clone(options),
+		c.WithMeshObjectAccept(ctx),
+		WithUrlQuery(map[string]any{"page": pageNumber}),
+	)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting page %d: %w", pageNumber, err)
+	}
+	items, ok := response.Embedded[embeddedKey]
+	if !ok {
+		return nil, 0, fmt.Errorf("embedded key %s not found in paginated response", embeddedKey)
+	}
+	if items == nil {
+		items = []M{}
 	}
+	return items, response.Page.TotalPages, nil
 }
@@ -0,0 +1,238 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MeshTestObject struct {
+	Name string `json:"name"`
+}
+
+func TestMeshObjectClient_List(t *testing.T) {
+	const totalPages, itemsPerPage = 7, 2
+	// newClient serves totalPages pages, delaying each page as given and failing it if the delay is negative.
+	newClient := func(t *testing.T, pageDelay func(page int) time.Duration) (client MeshObjectClient[MeshTestObject], maxInFlight func() int) {
+		t.Helper()
+		var mu sync.Mutex
+		inFlight, maxInFlightSeen := 0, 0
+		httpClient := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			inFlight++
+			maxInFlightSeen = max(maxInFlightSeen, inFlight)
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+			}()
+
+			page, err := strconv.Atoi(req.URL.Query().Get("page"))
+			require.NoError(t, err)
+			assert.Equal(t, "10", req.URL.Query().Get("size"))
+			delay := pageDelay(page)
+			if delay < 0 {
+				time.Sleep(-delay)
+				resp.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			time.Sleep(delay)
+			var items []MeshTestObject
+			for i := range itemsPerPage {
+				items = append(items, MeshTestObject{Name: fmt.Sprintf("item-%d-%d", page, i)})
+			}
+			resp.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(resp).Encode(map[string]any{
+				"_embedded": map[string]any{"meshTestObjects": items},
+				"page":      map[string]any{"number": page, "totalPages": totalPages},
+			})
+		})
+		httpClient.Authorization = BearerTokenAuthorization{Token: "some-token"}
+		return NewMeshObjectClient[MeshTestObject](t.Context(), httpClient, []string{"v1"}), func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return maxInFlightSeen
+		}
+	}
+	names := func(items []MeshTestObject) (result []string) {
+		for _, item := range items {
+			result = append(result, item.Name)
+		}
+		return
+	}
+
+	t.Run("fetches pages concurrently in order", func(t *testing.T) {
+		client, maxInFlight := newClient(t, func(page int) time.Duration {
+			// Later pages answer faster, yet the result keeps the page order.
+			return time.Duration(totalPages-page) * 5 * time.Millisecond
+		})
+		items, err := client.List(t.Context(), WithPageSize(10))
+		require.NoError(t, err)
+		var expected []string
+		for page := range totalPages {
+			for i := range itemsPerPage {
+				expected = append(expected, fmt.Sprintf("item-%d-%d", page, i))
+			}
+		}
+		assert.Equal(t, expected, names(items))
+		assert.Equal(t, listPageConcurrency, maxInFlight())
+	})
+
+	t.Run("reports the failed page with the pages before it", func(t *testing.T) {
+		client, _ := newClient(t, func(page int) time.Duration {
+			if page == 3 {
+				return -50 * time.Millisecond
+			}
+			return 5 * time.Millisecond
+		})
+		items, err := client.List(t.Context(), WithPageSize(10))
+		require.ErrorContains(t, err, "error getting page 3: http error 500")
+		assert.Equal(t, []string{"item-0-0", "item-0-1", "item-1-0", "item-1-1", "item-2-0", "item-2-1"}, names(items))
+	})
+
+	t.Run("All stops early without fetching all pages", func(t *testing.T) {
+		var mu sync.Mutex
+		var requestedPages []int
+		client, _ := newClient(t, func(page int) time.Duration {
+			mu.Lock()
+			requestedPages = append(requestedPages, page)
+			mu.Unlock()
+			return 5 * time.Millisecond
+		})
+		var items []MeshTestObject
+		for item, err := range client.All(t.Context(), WithPageSize(10)) {
+			require.NoError(t, err)
+			items = append(items, item)
+			if len(items) == 3 {
+				break
+			}
+		}
+		assert.Equal(t, []string{"item-0-0", "item-0-1", "item-1-0"}, names(items))
+		mu.Lock()
+		defer mu.Unlock()
+		assert.LessOrEqual(t, len(requestedPages), 2+listPageConcurrency, "only pages ahead of the consumer are fetched")
+	})
+
+	t.Run("fails on first page", func(t *testing.T) {
+		client, _ := newClient(t, func(page int) time.Duration {
+			return -1
+		})
+		items, err := client.List(t.Context(), WithPageSize(10))
+		require.ErrorContains(t, err, "error getting page 0: http error 500")
+		assert.Empty(t, items)
+	})
+}
+
+func TestMeshObjectClient_PostIdempotently(t *testing.T) {
+	// serverClockSkew is how far the Date header of the server is ahead of the client's clock.
+	var serverClockSkew time.Duration
+	// newClient answers the POST attempts with the given status codes in turn.
+	newClient := func(t *testing.T, statusCodes ...int) (client MeshObjectClient[MeshTestObject], idempotencyKeys *[]string) {
+		t.Helper()
+		idempotencyKeys = new([]string)
+		httpClient := WithRetry(newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			resp.Header().Set("Date", time.Now().Add(serverClockSkew).UTC().Format(http.TimeFormat))
+			*idempotencyKeys = append(*idempotencyKeys, req.Header.Get("Idempotency-Key"))
+			statusCode := statusCodes[len(*idempotencyKeys)-1]
+			resp.WriteHeader(statusCode)
+			if statusCode == http.StatusCreated {
+				_ = json.NewEncoder(resp).Encode(MeshTestObject{Name: "created"})
+			}
+		}), RetryOptions{MaxRetries: 3, Backoff: &retryTestBackoff{}})
+		httpClient.Authorization = BearerTokenAuthorization{Token: "some-token"}
+		return NewMeshObjectClient[MeshTestObject](t.Context(), httpClient, []string{"v1"}), idempotencyKeys
+	}
+	orphan := func(context.Context, time.Time) (*MeshTestObject, error) {
+		return &MeshTestObject{Name: "orphan"}, nil
+	}
+	noOrphanExpected := func(context.Context, time.Time) (*MeshTestObject, error) {
+		t.Error("unexpected lookup of orphan")
+		return nil, nil
+	}
+
+	t.Run("retries with the same key", func(t *testing.T) {
+		client, idempotencyKeys := newClient(t, http.StatusServiceUnavailable, http.StatusCreated)
+		created, err := client.PostIdempotently(t.Context(), MeshTestObject{}, noOrphanExpected)
+		require.NoError(t, err)
+		assert.Equal(t, "created", created.Name)
+		require.Len(t, *idempotencyKeys, 2)
+		assert.Len(t, (*idempotencyKeys)[0], 36)
+		assert.Equal(t, (*idempotencyKeys)[0], (*idempotencyKeys)[1])
+	})
+
+	t.Run("adopts the orphan of an earlier attempt", func(t *testing.T) {
+		client, _ := newClient(t, http.StatusBadGateway, http.StatusConflict)
+		start := time.Now()
+		created, err := client.PostIdempotently(t.Context(), MeshTestObject{}, func(ctx context.Context, createdAfter time.Time) (*MeshTestObject, error) {
+			// The Date header has a resolution of a second.
+			assert.False(t, createdAfter.Before(start.Add(-time.Second)), "orphans must be created after the first attempt")
+			assert.False(t, createdAfter.After(time.Now()))
+			return orphan(ctx, createdAfter)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "orphan", created.Name)
+	})
+
+	t.Run("looks for orphans in the server's time", func(t *testing.T) {
+		serverClockSkew = -time.Hour
+		t.Cleanup(func() { serverClockSkew = 0 })
+		client, _ := newClient(t, http.StatusBadGateway, http.StatusConflict)
+		start := time.Now().Add(serverClockSkew)
+		_, err := client.PostIdempotently(t.Context(), MeshTestObject{}, func(ctx context.Context, createdAfter time.Time) (*MeshTestObject, error) {
+			assert.WithinDuration(t, start, createdAfter, 2*time.Second)
+			return orphan(ctx, createdAfter)
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("fails without orphan", func(t *testing.T) {
+		client, _ := newClient(t, http.StatusBadGateway, http.StatusConflict)
+		_, err := client.PostIdempotently(t.Context(), MeshTestObject{}, func(context.Context, time.Time) (*MeshTestObject, error) {
+			return nil, nil
+		})
+		require.ErrorContains(t, err, "http error 409")
+	})
+
+	t.Run("never adopts on an error other than a conflict", func(t *testing.T) {
+		client, _ := newClient(t, http.StatusBadGateway, http.StatusBadRequest)
+		_, err := client.PostIdempotently(t.Context(), MeshTestObject{}, noOrphanExpected)
+		require.ErrorContains(t, err, "http error 400")
+	})
+
+	t.Run("never adopts after a single attempt", func(t *testing.T) {
+		client, _ := newClient(t, http.StatusConflict)
+		_, err := client.PostIdempotently(t.Context(), MeshTestObject{}, noOrphanExpected)
+		require.ErrorContains(t, err, "http error 409")
+	})
+}
+
+func TestNewMeshObjectClientOfKind(t *testing.T) {
+	var accept, requestPath string
+	httpClient := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+		accept, requestPath = req.Header.Get("Accept"), req.URL.Path
+		_, _ = resp.Write([]byte(`{"metadata":{"name":"auditor"}}`))
+	})
+	httpClient.Authorization = BearerTokenAuthorization{Token: "some-token"}
+
+	client, err := NewMeshObjectClientOfKind[json.RawMessage](httpClient, "meshProjectRole", "v1")
+	require.NoError(t, err)
+	object, err := client.Get(t.Context(), "auditor")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"name":"auditor"}}`, string(*object))
+	assert.Equal(t, "/api/meshobjects/meshprojectroles/auditor", requestPath)
+	assert.Equal(t, "application/vnd.meshcloud.api.meshProjectRole.v1.hal+json", accept)
+
+	_, err = NewMeshObjectClientOfKind[json.RawMessage](httpClient, "../meshProjects", "v1")
+	require.Error(t, err)
+	_, err = NewMeshObjectClientOfKind[json.RawMessage](httpClient, "meshProjectRole", "v1/..")
+	require.Error(t, err)
+}
@@ -2,10 +2,14 @@ package internal
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
+	"sync/atomic"
 )
 
 type (
@@ -17,11 +21,17 @@ type (
 		extraPathElems   []string
 		requestPayload   any
 		requestModifiers []requestModifier
+		responseHandlers []responseHandler
+		// tracedMeshObject names the request in traces, see withTracedMeshObject.
+		tracedMeshObject tracedMeshObject
+		// attempts counts the attempts sent by the retry layer, see withAttempts.
+		attempts *atomic.Int32
 		// optionErr holds the first error produced while applying options (e.g. an unmarshalable
 		// query); doRequest surfaces it instead of building a request from partial options.
 		optionErr error
 	}
 	requestModifier func(req *http.Request)
+	responseHandler func(res *http.Response)
 )
 
 // WithUrlQuery adds URL query parameters from a query value.
@@ -63,6 +73,12 @@ func WithUrlQuery(query any) RequestOption {
 	}
 }
 
+// WithPageSize sets the number of meshObjects per page requested by MeshObjectClient.List,
+// instead of the backend's default. Larger pages mean fewer requests for long lists.
+func WithPageSize(size int) RequestOption {
+	return WithUrlQuery(map[string]any{"size": size})
+}
+
 // WithPathElems appends path elements to the request URL path.
 func WithPathElems(pathElems ...string) RequestOption {
 	return func(opts *requestOptions) {
@@ -76,6 +92,95 @@ func appendRequestModifier(modifier requestModifier) RequestOption {
 	}
 }
 
+// WithIfMatch makes the request conditional on the object still having the given ETag, see
+// WithResponseETag. The request fails with HttpError.IsPreconditionFailed otherwise.
+// An empty etag (e.g. as the backend did not send one) leaves the request unconditional.
+func WithIfMatch(etag string) RequestOption {
+	return func(opts *requestOptions) {
+		if etag != "" {
+			withHeader("If-Match", etag)(opts)
+		}
+	}
+}
+
+// WithResponseETag stores the ETag header of the response in etag, which is empty if there is none.
+func WithResponseETag(etag *string) RequestOption {
+	return withResponseHeader("ETag", etag)
+}
+
+func withResponseHeader(key string, value *string) RequestOption {
+	return func(opts *requestOptions) {
+		opts.responseHandlers = append(opts.responseHandlers, func(res *http.Response) {
+			*value = res.Header.Get(key)
+		})
+	}
+}
+
+// withTracedMeshObject names the request in traces after the meshObject kind and the meshObject
+// collection path, see tracedMeshObject.PathTemplate.
+func withTracedMeshObject(kind, collectionPath string) RequestOption {
+	return func(opts *requestOptions) {
+		opts.tracedMeshObject = tracedMeshObject{kind, collectionPath}
+	}
+}
+
+type tracedMeshObject struct {
+	Kind, CollectionPath string
+}
+
+// PathTemplate replaces the meshObject identifier following the collection path by {id}, e.g.
+// /api/meshobjects/meshbuildingblocks/{id}/trigger-run, so that requests for different meshObjects
+// share the same template. Paths outside the collection, e.g. /api/login, are returned as they are.
+// The template always starts with a slash, unlike the path of a URL joined to a root URL without path.
+func (t tracedMeshObject) PathTemplate(path string) string {
+	template := path
+	if rest, ok := strings.CutPrefix(path, t.CollectionPath+"/"); ok && t.CollectionPath != "" {
+		if _, subPath, hasSubPath := strings.Cut(rest, "/"); hasSubPath {
+			template = t.CollectionPath + "/{id}/" + subPath
+		} else {
+			template = t.CollectionPath + "/{id}"
+		}
+	}
+	if !strings.HasPrefix(template, "/") {
+		template = "/" + template
+	}
+	return template
+}
+
+// WithIdempotencyKey sends the given Idempotency-Key header, which makes meshStack process the request
+// only once, even if it is sent again. This allows WithRetry to retry a POST carrying it. Use one key
+// per logical request, see NewIdempotencyKey.
+func WithIdempotencyKey(key string) RequestOption {
+	return withHeader(idempotencyKeyHeader, key)
+}
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// NewIdempotencyKey returns a random UUID for WithIdempotencyKey.
+func NewIdempotencyKey() string {
+	var uuid [16]byte
+	_, _ = rand.Read(uuid[:])     // never returns an error
+	uuid[6] = uuid[6]&0x0f | 0x40 // version 4
+	uuid[8] = uuid[8]&0x3f | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
+}
+
+// withAttempts counts the attempts sent for the request in attempts, which is more than one if it was retried.
+func withAttempts(attempts *atomic.Int32) RequestOption {
+	return func(opts *requestOptions) {
+		opts.attempts = attempts
+	}
+}
+
+type attemptsContextKey struct{}
+
+// countAttempt counts an attempt sent for the request with ctx, if requested with withAttempts.
+func countAttempt(ctx context.Context) {
+	if attempts, ok := ctx.Value(attemptsContextKey{}).(*atomic.Int32); ok {
+		attempts.Add(1)
+	}
+}
+
 func WithAccept(accept string) RequestOption {
 	return withHeader("Accept", accept)
 }
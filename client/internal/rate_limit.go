@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithRateLimit sets up the given client to limit the rate and concurrency of requests. See RateLimitOptions.
+// Apply it before WithRetry, so that the limit sits below the retry layer: every retry attempt then
+// waits for the limiter as well, and retries of concurrent requests cannot stampede the backend.
+//
+// The limiter adapts to the backend: a response carrying a Retry-After header (429 or 503) pauses all
+// requests until the indicated time, and a 429 additionally halves the request rate, which then
+// recovers gradually with every successful response.
+func WithRateLimit(c HttpClient, options RateLimitOptions) HttpClient {
+	next := http.DefaultTransport
+	if c.Transport != nil {
+		next = c.Transport
+	}
+	roundTripper := &rateLimitRoundTripper{
+		Next: next,
+		Limiter: &rateLimiter{
+			maxRate: options.RequestsPerSecond,
+			rate:    options.RequestsPerSecond,
+			burst:   float64(max(options.Burst, 1)),
+			tokens:  float64(max(options.Burst, 1)),
+		},
+	}
+	if options.MaxInFlight > 0 {
+		roundTripper.InFlight = make(chan struct{}, options.MaxInFlight)
+	}
+	c.Transport = roundTripper
+	return c // for fluent API
+}
+
+// RateLimitOptions configure WithRateLimit.
+type RateLimitOptions struct {
+	// RequestsPerSecond limits the sustained request rate. If zero, the rate is only limited while
+	// the backend asks to slow down.
+	RequestsPerSecond float64
+	// Burst is the number of requests which may be sent at once before RequestsPerSecond applies.
+	// Defaults to 1.
+	Burst int
+	// MaxInFlight limits the number of concurrent requests. If zero, concurrency is not limited.
+	MaxInFlight int
+}
+
+// rateLimitRoundTripper wraps an http.RoundTripper to limit the rate and concurrency of requests.
+// See WithRateLimit.
+type rateLimitRoundTripper struct {
+	Next    http.RoundTripper
+	Limiter *rateLimiter
+	// InFlight is a semaphore with a capacity of RateLimitOptions.MaxInFlight, nil if unlimited.
+	InFlight chan struct{}
+}
+
+func (r *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if r.InFlight != nil {
+		select {
+		case r.InFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := sync.OnceFunc(func() {
+		if r.InFlight != nil {
+			<-r.InFlight
+		}
+	})
+	if err := r.Limiter.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	resp, err := r.Next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	r.Limiter.Observe(ctx, resp)
+	// The request is in flight until its response body is consumed.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, Release: release}
+	return resp, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	Release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.Release()
+	return b.ReadCloser.Close()
+}
+
+// rateLimiter is a token bucket, which refills at rate up to burst tokens. The tokens may become
+// negative, which reserves future tokens for the waiting requests in the order they arrived.
+type rateLimiter struct {
+	mu sync.Mutex
+	// maxRate is the configured rate, which rate recovers to after slowing down. Zero means unlimited.
+	maxRate     float64
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// Wait blocks until the request may be sent, or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	waitTime := l.reserve()
+	if waitTime <= 0 {
+		return nil
+	}
+	Log.Debug(ctx, "rate limiting request", "waitTime", waitTime)
+	timer := time.NewTimer(waitTime)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l *rateLimiter) reserve() (waitTime time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := timeNow()
+	if l.pausedUntil.After(now) {
+		waitTime = l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return
+	}
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens < 0 {
+		waitTime = max(waitTime, time.Duration(-l.tokens/l.rate*float64(time.Second)))
+	}
+	return
+}
+
+// Observe adapts the limiter to the response: a Retry-After header pauses all requests, and a 429
+// halves the rate. Each other response lets a reduced rate recover by a tenth of the configured rate.
+func (l *rateLimiter) Observe(ctx context.Context, resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		if resp.Header.Get("Retry-After") != "" {
+			// Fallback is only used for an unparseable header, don't pause in this case.
+			pause := retryAfterBackoff{Response: resp, Fallback: ExponentialBackoff{}}.Calculate(1)
+			if pausedUntil := timeNow().Add(pause); pausedUntil.After(l.pausedUntil) {
+				l.pausedUntil = pausedUntil
+				Log.Info(ctx, "backend asked to slow down, pausing requests", "status", resp.StatusCode, "pause", pause)
+			}
+		}
+		if resp.StatusCode == http.StatusTooManyRequests && l.maxRate > 0 {
+			l.rate = max(l.rate/2, l.maxRate/16)
+			Log.Info(ctx, "backend rate limit exceeded, reducing request rate", "requestsPerSecond", l.rate)
+		}
+	default:
+		if l.rate < l.maxRate {
+			l.rate = min(l.rate+l.maxRate/10, l.maxRate)
+		}
+	}
+}
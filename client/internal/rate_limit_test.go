@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newRateLimitTestClient(options RateLimitOptions, handler roundTripperFunc) HttpClient {
+	return WithRateLimit(HttpClient{Client: &http.Client{Transport: handler}}, options)
+}
+
+func okResponse(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func doRateLimitTestRequest(t *testing.T, client HttpClient) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://meshstack.invalid/get", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Run("requests are spaced by the rate after the burst", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			client := newRateLimitTestClient(RateLimitOptions{RequestsPerSecond: 10, Burst: 2}, okResponse)
+			start := time.Now()
+			for range 4 {
+				doRateLimitTestRequest(t, client)
+			}
+			assert.Equal(t, 200*time.Millisecond, time.Since(start), "2 requests of the burst, then 2 at 100ms each")
+		})
+	})
+
+	t.Run("concurrency is capped until the response body is closed", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			inFlight, maxInFlight := 0, 0
+			var mu sync.Mutex
+			client := newRateLimitTestClient(RateLimitOptions{MaxInFlight: 2}, func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				inFlight++
+				maxInFlight = max(maxInFlight, inFlight)
+				mu.Unlock()
+				time.Sleep(1 * time.Second)
+				resp, err := okResponse(req)
+				resp.Body = &releasingBody{ReadCloser: resp.Body, Release: func() {
+					mu.Lock()
+					inFlight--
+					mu.Unlock()
+				}}
+				return resp, err
+			})
+			start := time.Now()
+			var wg sync.WaitGroup
+			for range 6 {
+				wg.Go(func() {
+					doRateLimitTestRequest(t, client)
+				})
+			}
+			wg.Wait()
+			assert.Equal(t, 2, maxInFlight)
+			assert.Equal(t, 3*time.Second, time.Since(start))
+		})
+	})
+
+	t.Run("Retry-After pauses all requests and 429 halves the rate", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			attempts := 0
+			client := newRateLimitTestClient(RateLimitOptions{RequestsPerSecond: 10, Burst: 1}, func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts == 1 {
+					return &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"5"}}, Body: http.NoBody}, nil
+				}
+				return okResponse(req)
+			})
+			limiter := client.Transport.(*rateLimitRoundTripper).Limiter //nolint:forcetypeassert // set by WithRateLimit
+
+			start := time.Now()
+			doRateLimitTestRequest(t, client)
+			assert.InDelta(t, 5.0, limiter.rate, 0.001)
+			doRateLimitTestRequest(t, client)
+			assert.Equal(t, 5*time.Second, time.Since(start), "request after 429 must wait for Retry-After")
+			assert.InDelta(t, 6.0, limiter.rate, 0.001, "rate recovers with successful responses")
+		})
+	})
+}
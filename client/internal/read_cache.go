@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// ReadCache caches the responses of meshObject GET requests and coalesces identical concurrent ones,
+// so that a meshObject read many times during a Terraform run is requested only once.
+//
+// A Post, Put or Delete of any kind invalidates all cached responses, see HttpClient.doRequest: a write
+// often changes meshObjects of other kinds as well, e.g. creating a tenant changes the status of its
+// project, a building block run changes its tenant, and deleting a workspace removes its bindings.
+// Responses are not cached beyond that, so a ReadCache must only live as long as a single run, e.g.
+// one provider instance. Requests with a context from WithoutReadCache always reach meshStack.
+type ReadCache struct {
+	mu sync.Mutex
+	// generation counts the invalidations, a response fetched during an invalidation is not cached.
+	generation int
+	entries    map[readCacheKey][]byte
+	calls      map[readCacheKey]*readCacheCall
+}
+
+func NewReadCache() *ReadCache {
+	return &ReadCache{
+		entries: map[readCacheKey][]byte{},
+		calls:   map[readCacheKey]*readCacheCall{},
+	}
+}
+
+type readCacheKey struct {
+	Kind, Url, Accept string
+}
+
+func newReadCacheKey(kind string, req *http.Request) readCacheKey {
+	return readCacheKey{kind, req.URL.String(), req.Header.Get("Accept")}
+}
+
+// readCacheCall is a GET request in flight, which identical requests wait for instead of sending their own.
+type readCacheCall struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+const spanAttributeReadCache = "meshstack.read_cache"
+
+type withoutReadCacheContextKey struct{}
+
+// WithoutReadCache makes the requests with the returned context bypass the ReadCache, e.g. to poll the status of a meshObject.
+// Their responses still update the cache.
+func WithoutReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutReadCacheContextKey{}, true)
+}
+
+// Get returns the cached response body for key, or the one of an identical request in flight, or fetches it.
+// Errors are not cached. The fetch shared by identical requests runs detached from the cancellation of
+// their contexts, so that a cancelled caller does not fail the others waiting for it.
+func (c *ReadCache) Get(ctx context.Context, key readCacheKey, fetch func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	span := requestSpan(ctx)
+	bypass, _ := ctx.Value(withoutReadCacheContextKey{}).(bool)
+	c.mu.Lock()
+	generation := c.generation
+	if bypass {
+		c.mu.Unlock()
+		span.SetAttribute(spanAttributeReadCache, "miss")
+		body, err := fetch(ctx)
+		c.store(key, generation, body, err)
+		return body, err
+	}
+	if body, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		span.SetAttribute(spanAttributeReadCache, "hit")
+		return body, nil
+	}
+	call, ok := c.calls[key]
+	if ok {
+		span.SetAttribute(spanAttributeReadCache, "coalesced")
+	} else {
+		span.SetAttribute(spanAttributeReadCache, "miss")
+		call = &readCacheCall{done: make(chan struct{})}
+		c.calls[key] = call
+		go func() {
+			call.body, call.err = fetch(context.WithoutCancel(ctx))
+			c.mu.Lock()
+			if c.calls[key] == call {
+				delete(c.calls, key)
+			}
+			c.mu.Unlock()
+			c.store(key, generation, call.body, call.err)
+			close(call.done)
+		}()
+	}
+	c.mu.Unlock()
+	select {
+	case <-call.done:
+		return call.body, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// store caches body unless the request failed or the cache was invalidated since generation.
+func (c *ReadCache) store(key readCacheKey, generation int, body []byte, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil && c.generation == generation {
+		c.entries[key] = body
+	}
+}
+
+// Invalidate drops all cached responses. Requests in flight are no longer waited for, and their
+// responses are not cached, as they might have been sent before the change.
+func (c *ReadCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	clear(c.entries)
+	clear(c.calls)
+}
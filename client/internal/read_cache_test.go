@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCache(t *testing.T) {
+	newClient := func(t *testing.T, handler http.HandlerFunc) (client MeshObjectClient[MeshTestObject], requests *atomic.Int32) {
+		t.Helper()
+		requests = new(atomic.Int32)
+		httpClient := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+			handler(resp, req)
+		})
+		httpClient.Authorization = BearerTokenAuthorization{Token: "some-token"}
+		httpClient.ReadCache = NewReadCache()
+		return NewMeshObjectClient[MeshTestObject](t.Context(), httpClient, []string{"v1"}), requests
+	}
+	respondName := func(name string) http.HandlerFunc {
+		return func(resp http.ResponseWriter, req *http.Request) {
+			resp.Header().Set("ETag", `"some-etag"`)
+			_ = json.NewEncoder(resp).Encode(MeshTestObject{Name: name})
+		}
+	}
+
+	t.Run("repeated reads are cached until a write", func(t *testing.T) {
+		name := "before"
+		client, requests := newClient(t, func(resp http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPut {
+				name = "after"
+			}
+			respondName(name)(resp, req)
+		})
+		for range 3 {
+			object, err := client.Get(t.Context(), "some-object")
+			require.NoError(t, err)
+			assert.Equal(t, "before", object.Name)
+		}
+		other, err := client.Get(t.Context(), "other-object")
+		require.NoError(t, err)
+		assert.Equal(t, "before", other.Name)
+		assert.EqualValues(t, 2, requests.Load())
+
+		_, err = client.Put(t.Context(), "other-object", MeshTestObject{Name: "after"})
+		require.NoError(t, err)
+		object, err := client.Get(t.Context(), "some-object")
+		require.NoError(t, err)
+		assert.Equal(t, "after", object.Name)
+		assert.EqualValues(t, 4, requests.Load())
+	})
+
+	t.Run("a write of another kind invalidates too", func(t *testing.T) {
+		name := "before"
+		client, requests := newClient(t, func(resp http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPost {
+				name = "after"
+			}
+			respondName(name)(resp, req)
+		})
+		otherKind, err := NewMeshObjectClientOfKind[MeshTestObject](client.HttpClient, "meshOtherObject", "v1")
+		require.NoError(t, err)
+
+		_, err = client.Get(t.Context(), "some-object")
+		require.NoError(t, err)
+		_, err = otherKind.Post(t.Context(), MeshTestObject{Name: "after"})
+		require.NoError(t, err)
+		object, err := client.Get(t.Context(), "some-object")
+		require.NoError(t, err)
+		assert.Equal(t, "after", object.Name)
+		assert.EqualValues(t, 3, requests.Load())
+	})
+
+	t.Run("a cancelled reader does not fail the coalesced ones", func(t *testing.T) {
+		release := make(chan struct{})
+		client, requests := newClient(t, func(resp http.ResponseWriter, req *http.Request) {
+			<-release
+			respondName("some-name")(resp, req)
+		})
+		ctx, cancel := context.WithCancel(t.Context())
+		first := make(chan error)
+		go func() {
+			_, err := client.Get(ctx, "some-object")
+			first <- err
+		}()
+		for requests.Load() == 0 {
+			runtime.Gosched()
+		}
+		second := make(chan error)
+		go func() {
+			object, err := client.Get(t.Context(), "some-object")
+			if err == nil {
+				assert.Equal(t, "some-name", object.Name)
+			}
+			second <- err
+		}()
+
+		cancel()
+		require.ErrorIs(t, <-first, context.Canceled)
+		close(release)
+		require.NoError(t, <-second)
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("identical concurrent reads are coalesced", func(t *testing.T) {
+		release := make(chan struct{})
+		client, requests := newClient(t, func(resp http.ResponseWriter, req *http.Request) {
+			<-release
+			respondName("some-name")(resp, req)
+		})
+		const readers = 5
+		var wg sync.WaitGroup
+		for range readers {
+			wg.Go(func() {
+				object, err := client.Get(t.Context(), "some-object")
+				assert.NoError(t, err)
+				assert.Equal(t, "some-name", object.Name)
+			})
+		}
+		// Wait for the first request to reach the server, the other readers wait for it.
+		for requests.Load() == 0 {
+			runtime.Gosched()
+		}
+		close(release)
+		wg.Wait()
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		client, requests := newClient(t, func(resp http.ResponseWriter, req *http.Request) {
+			resp.WriteHeader(http.StatusForbidden)
+		})
+		for range 2 {
+			_, err := client.Get(t.Context(), "some-object")
+			require.Error(t, err)
+		}
+		assert.EqualValues(t, 2, requests.Load())
+	})
+
+	t.Run("bypassed by WithoutReadCache and for ETags", func(t *testing.T) {
+		client, requests := newClient(t, respondName("some-name"))
+		_, err := client.Get(t.Context(), "some-object")
+		require.NoError(t, err)
+		_, err = client.Get(WithoutReadCache(t.Context()), "some-object")
+		require.NoError(t, err)
+		var etag string
+		_, err = client.Get(t.Context(), "some-object", WithResponseETag(&etag))
+		require.NoError(t, err)
+		assert.Equal(t, `"some-etag"`, etag)
+		assert.EqualValues(t, 3, requests.Load())
+	})
+}
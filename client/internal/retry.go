@@ -14,8 +14,9 @@ import (
 )
 
 // WithRetry sets up the given client to retry certain requests.
-// The idempotent methods GET, PUT and DELETE are retried by default, POST only if the path is
-// explicitly whitelisted. See RetryOptions.
+// The idempotent methods GET, PUT and DELETE are retried by default, POST only if it carries an
+// Idempotency-Key (see WithIdempotencyKey) or the path is explicitly whitelisted. See RetryOptions.
+// Conditional writes (see WithIfMatch) are never retried.
 func WithRetry(c HttpClient, options RetryOptions) HttpClient {
 	next := http.DefaultTransport
 	if c.Transport != nil {
@@ -34,17 +35,28 @@ func WithRetry(c HttpClient, options RetryOptions) HttpClient {
 	c.Transport = &retryRoundTripper{
 		Next:       next,
 		MaxRetries: options.MaxRetries,
+		Breaker:    sharedCircuitBreaker(c.RootUrl, options.CircuitBreaker),
 		// ShouldRetryRequest checks if the request method/path is eligible for retry.
 		ShouldRetryRequest: func(req *http.Request) (retry bool) {
 			if options.Backoff == nil {
 				return false
 			}
+			if req.Header.Get("If-Match") != "" {
+				// A write that succeeded before e.g. a proxy 503 changed the ETag, so its retry would fail
+				// with a 412 Precondition Failed, reporting a concurrent modification that never happened.
+				return false
+			}
 			switch req.Method {
 			case http.MethodGet, http.MethodPut, http.MethodDelete:
 				// Idempotent methods are safe to retry: replaying them cannot create duplicate
 				// side effects. A DELETE that actually succeeded server-side before a proxy 503
 				// simply yields a 404 on replay, which delete handlers already treat as done.
 				return true
+			case http.MethodPost:
+				if req.Header.Get(idempotencyKeyHeader) != "" {
+					// meshStack processes the request only once, the retry can at most find it processed already.
+					return true
+				}
 			}
 			if whitelisted, found := whitelistedByMethodAndUrl[req.Method]; found {
 				_, retry = whitelisted.Load(req.URL.String())
@@ -85,6 +97,9 @@ type RetryOptions struct {
 	Backoff RetryBackoff
 	// WhitelistedPaths allow methods beyond GET and PUT to be retried as well, see WithRetry.
 	WhitelistedPaths map[string][]string
+	// CircuitBreaker pauses all requests to the same endpoint while the backend is unavailable.
+	// Disabled if FailureThreshold is zero.
+	CircuitBreaker CircuitBreakerOptions
 }
 
 // RetryBackoff calculates the duration to wait before the next retry attempt.
@@ -149,15 +164,31 @@ type retryRoundTripper struct {
 	MaxRetries          int
 	ShouldRetryRequest  func(req *http.Request) bool
 	ShouldRetryResponse func(resp *http.Response, err error) RetryBackoff
+	// Breaker is optional, nil disables it.
+	Breaker *circuitBreaker
+}
+
+type withoutRetryContextKey struct{}
+
+// withoutRetry makes the requests with the returned context be sent once, even if they would be retried.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutRetryContextKey{}, true)
 }
 
 func (r *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if !r.ShouldRetryRequest(req) {
-		return r.Next.RoundTrip(req)
+	if req.Context().Value(withoutRetryContextKey{}) != nil || !r.ShouldRetryRequest(req) {
+		return r.roundTripOnce(req)
 	}
 	req = makeRequestBodyRetryable(req)
 	for attempt := 1; ; attempt++ {
-		resp, err := r.Next.RoundTrip(req)
+		if attempt > 1 {
+			requestSpan(req.Context()).SetAttribute(spanAttributeResendCount, attempt-1)
+		}
+		resp, err := r.roundTripOnce(req)
+		var unavailable BackendUnavailableError
+		if errors.As(err, &unavailable) {
+			return resp, err
+		}
 		if errors.Is(err, errRetryableBodyClose) {
 			return resp, err
 		}
@@ -197,6 +228,18 @@ func (r *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 	}
 }
 
+// roundTripOnce waits while the circuit is open before passing the request on to Next,
+// and records the outcome with the circuit breaker.
+func (r *retryRoundTripper) roundTripOnce(req *http.Request) (*http.Response, error) {
+	if err := r.Breaker.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	countAttempt(req.Context())
+	resp, err := r.Next.RoundTrip(req)
+	r.Breaker.Record(req.Context(), r.Next, resp, err)
+	return resp, err
+}
+
 func makeRequestBodyRetryable(req *http.Request) *http.Request {
 	if req.Body == nil {
 		return req
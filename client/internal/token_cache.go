@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TokenCache persists access tokens as files in Dir, so that the many short-lived provider processes
+// Terraform starts during a single run can share one login instead of each logging in again.
+// Entries are keyed by endpoint and client id, see tokenCacheKey.
+type TokenCache struct {
+	Dir string
+}
+
+const (
+	// tokenCacheLockPollInterval is how often a process waiting for another one's login checks the lock.
+	tokenCacheLockPollInterval = 100 * time.Millisecond
+	// tokenCacheStaleLockAge is the age after which a lock is considered abandoned by a crashed process.
+	// It must exceed the longest a live login can hold the lock, which includes retrying the login
+	// request through the retry and circuit breaker budget of several minutes.
+	tokenCacheStaleLockAge = 15 * time.Minute
+	// tokenCacheLockTimeout bounds how long a process waits for the lock, so that a lock left behind by a
+	// crashed process delays it by at most this long, until it logs in without the cache.
+	tokenCacheLockTimeout = 30 * time.Second
+)
+
+// errTokenCacheLockTimeout is returned by TokenCache.lock if the lock is still held after
+// tokenCacheLockTimeout.
+var errTokenCacheLockTimeout = errors.New("token cache lock still held by another process")
+
+type cachedToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func tokenCacheKey(rootUrl *url.URL, clientId string) string {
+	sum := sha256.Sum256([]byte(rootUrl.String() + "\n" + clientId))
+	return hex.EncodeToString(sum[:])
+}
+
+// lock acquires an exclusive lock for key across processes, using a lock file created with O_EXCL as
+// this works the same on every platform. The lock file holds a random owner token, so that unlock only
+// removes the lock it acquired, not one another process took over after considering it stale.
+// It gives up with errTokenCacheLockTimeout after tokenCacheLockTimeout. The returned unlock func must be
+// called once done.
+func (c TokenCache) lock(ctx context.Context, key string) (unlock func(), err error) {
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("cannot create token cache directory: %w", err)
+	}
+	lockPath := filepath.Join(c.Dir, key+".lock")
+	owner := rand.Text()
+	deadline := timeNow().Add(tokenCacheLockTimeout)
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_, writeErr := lockFile.WriteString(owner)
+			if closeErr := lockFile.Close(); writeErr == nil {
+				writeErr = closeErr
+			}
+			if writeErr != nil {
+				_ = os.Remove(lockPath)
+				return nil, fmt.Errorf("cannot write token cache lock: %w", writeErr)
+			}
+			return func() {
+				removeLockIfOwned(lockPath, owner)
+			}, nil
+		} else if !errors.Is(err, fs.ErrExist) {
+			return nil, fmt.Errorf("cannot create token cache lock: %w", err)
+		}
+		if info, err := os.Stat(lockPath); err == nil && timeNow().Sub(info.ModTime()) > tokenCacheStaleLockAge {
+			if staleOwner, err := os.ReadFile(lockPath); err == nil {
+				removeLockIfOwned(lockPath, string(staleOwner))
+			}
+			continue
+		}
+		if timeNow().After(deadline) {
+			return nil, errTokenCacheLockTimeout
+		}
+		timer := time.NewTimer(tokenCacheLockPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// removeLockIfOwned removes the lock file only if it still holds the given owner token. Checking and
+// removing is not atomic, but it narrows the window in which a lock of another process is removed from
+// the whole time a lock is held to the instant between the two calls.
+func removeLockIfOwned(lockPath, owner string) {
+	if current, err := os.ReadFile(lockPath); err == nil && string(current) == owner {
+		_ = os.Remove(lockPath)
+	}
+}
+
+// load returns the token cached for key, if any. A missing or unreadable entry is not an error, as
+// the caller falls back to logging in anyway.
+func (c TokenCache) load(key string) (token cachedToken, found bool) {
+	data, err := os.ReadFile(filepath.Join(c.Dir, key+".json"))
+	if err != nil {
+		return
+	}
+	found = json.Unmarshal(data, &token) == nil && token.Token != ""
+	return
+}
+
+// drop removes the entry for key if it still holds token, so that a token meshStack rejected is not
+// handed to other processes. It takes the lock, but gives up silently as the entry expires anyway.
+func (c TokenCache) drop(ctx context.Context, key, token string) {
+	unlock, err := c.lock(ctx, key)
+	if err != nil {
+		return
+	}
+	defer unlock()
+	if cached, found := c.load(key); found && cached.Token == token {
+		_ = os.Remove(filepath.Join(c.Dir, key+".json"))
+	}
+}
+
+// store writes the token for key readable by the current user only, as os.CreateTemp creates files
+// with mode 0600. The entry is replaced atomically, so a concurrent reader never sees a partially
+// written file.
+func (c TokenCache) store(key string, token cachedToken) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(c.Dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), filepath.Join(c.Dir, key+".json"))
+}
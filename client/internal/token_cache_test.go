@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSecretAuthorizationWithTokenCache(t *testing.T) {
+	logins := 0
+	client := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/login":
+			logins++
+			resp.WriteHeader(http.StatusOK)
+			_, _ = resp.Write([]byte(`{"access_token":"cached-token", "expires_in": 3600}`))
+		case "/edit":
+			assert.Equal(t, "Bearer cached-token", req.Header.Get("Authorization"))
+			resp.WriteHeader(http.StatusAccepted)
+		default:
+			t.Fatal("unexpected request", req.URL.Path)
+		}
+	})
+	cache := &TokenCache{Dir: filepath.Join(t.TempDir(), "tokens")}
+
+	// Each authorization stands for a separate provider process sharing the cache.
+	for range 3 {
+		client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", cache)
+		_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, logins, "processes sharing the cache must log in only once")
+
+	cacheKey := tokenCacheKey(client.RootUrl, "test-client")
+	info, err := os.Stat(filepath.Join(cache.Dir, cacheKey+".json"))
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+	assert.NoFileExists(t, filepath.Join(cache.Dir, cacheKey+".lock"), "lock must be released")
+
+	t.Run("expiring cached token triggers login", func(t *testing.T) {
+		require.NoError(t, cache.store(cacheKey, cachedToken{Token: "expiring-token", ExpiresAt: time.Now().Add(10 * time.Second)}))
+		client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", cache)
+		_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, logins)
+		cached, found := cache.load(cacheKey)
+		require.True(t, found)
+		assert.Equal(t, "cached-token", cached.Token)
+	})
+
+	t.Run("stale lock of a crashed process is broken", func(t *testing.T) {
+		lockPath := filepath.Join(cache.Dir, cacheKey+".lock")
+		require.NoError(t, os.WriteFile(lockPath, nil, 0o600))
+		staleTime := time.Now().Add(-2 * tokenCacheStaleLockAge)
+		require.NoError(t, os.Chtimes(lockPath, staleTime, staleTime))
+		unlock, err := cache.lock(t.Context(), cacheKey)
+		require.NoError(t, err)
+		unlock()
+		assert.NoFileExists(t, lockPath)
+	})
+
+	t.Run("unlock keeps a lock taken over by another process", func(t *testing.T) {
+		lockPath := filepath.Join(cache.Dir, cacheKey+".lock")
+		unlock, err := cache.lock(t.Context(), cacheKey)
+		require.NoError(t, err)
+		// Another process considered the lock stale and took it over.
+		require.NoError(t, os.WriteFile(lockPath, []byte("other-owner"), 0o600))
+		unlock()
+		assert.FileExists(t, lockPath, "must not remove the lock of another process")
+		require.NoError(t, os.Remove(lockPath))
+	})
+
+	t.Run("lock of a slow login is not stale", func(t *testing.T) {
+		lockPath := filepath.Join(cache.Dir, cacheKey+".lock")
+		require.NoError(t, os.WriteFile(lockPath, []byte("other-owner"), 0o600))
+		// A login retried through the whole retry and circuit breaker budget takes about 4 minutes.
+		slowLoginTime := time.Now().Add(-5 * time.Minute)
+		require.NoError(t, os.Chtimes(lockPath, slowLoginTime, slowLoginTime))
+		ctx, cancel := context.WithTimeout(t.Context(), 3*tokenCacheLockPollInterval)
+		defer cancel()
+		_, err := cache.lock(ctx, cacheKey)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.FileExists(t, lockPath)
+		require.NoError(t, os.Remove(lockPath))
+	})
+
+	t.Run("lock held too long logs in without cache", func(t *testing.T) {
+		lockPath := filepath.Join(cache.Dir, cacheKey+".lock")
+		require.NoError(t, os.WriteFile(lockPath, []byte("other-owner"), 0o600))
+		// Each look at the clock advances it by 10s, so the lock timeout passes after a few polls.
+		now := time.Now()
+		timeNow = func() time.Time {
+			now = now.Add(10 * time.Second)
+			return now
+		}
+		t.Cleanup(func() {
+			timeNow = time.Now
+		})
+		_, err := cache.lock(t.Context(), cacheKey)
+		require.ErrorIs(t, err, errTokenCacheLockTimeout)
+
+		loginsBefore := logins
+		client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", cache)
+		_, err = DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+		require.NoError(t, err)
+		assert.Equal(t, loginsBefore+1, logins)
+		assert.FileExists(t, lockPath, "must not break a lock that is not stale")
+		require.NoError(t, os.Remove(lockPath))
+	})
+}
+
+func TestClientSecretAuthorizationDropsRejectedToken(t *testing.T) {
+	logins := 0
+	client := newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/login":
+			logins++
+			resp.WriteHeader(http.StatusOK)
+			_, _ = resp.Write([]byte(`{"access_token":"new-token", "expires_in": 3600}`))
+		case "/edit":
+			if req.Header.Get("Authorization") == "Bearer revoked-token" {
+				resp.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			resp.WriteHeader(http.StatusAccepted)
+		default:
+			t.Fatal("unexpected request", req.URL.Path)
+		}
+	})
+	cache := &TokenCache{Dir: filepath.Join(t.TempDir(), "tokens")}
+	cacheKey := tokenCacheKey(client.RootUrl, "test-client")
+	require.NoError(t, os.MkdirAll(cache.Dir, 0o700))
+	require.NoError(t, cache.store(cacheKey, cachedToken{Token: "revoked-token", ExpiresAt: time.Now().Add(time.Hour)}))
+	client.Authorization = NewClientSecretAuthorization("login", "test-client", "test-client-secret", cache)
+
+	_, err := DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+	httpErr, ok := errors.AsType[HttpError](err)
+	require.True(t, ok, "expected HttpError, got %v", err)
+	assert.True(t, httpErr.IsUnauthorized())
+	_, found := cache.load(cacheKey)
+	assert.False(t, found, "rejected token must be dropped from the cache")
+
+	_, err = DoAuthorizedRequest[any](t.Context(), client, http.MethodPut, client.RootUrl.JoinPath("edit"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, logins)
+	cached, found := cache.load(cacheKey)
+	require.True(t, found)
+	assert.Equal(t, "new-token", cached.Token)
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+)
+
+var Tracing Tracer = noopTracer{}
+
+// Tracer traces the requests to meshStack, with one span per DoRequest.
+// This keeps the client free of a tracing library, see client.SetTracer for the OpenTelemetry attributes set.
+type Tracer interface {
+	// Start starts a span as child of the span in ctx, if any, and returns the context containing the new span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+	// Inject adds the headers propagating the span in ctx to header, e.g. the W3C traceparent.
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a span started by Tracer. Attribute values are string or int.
+type Span interface {
+	SetAttribute(key string, value any)
+	SetError(err error)
+	End()
+}
+
+const (
+	spanAttributeMethod      = "http.request.method"
+	spanAttributeUrlTemplate = "url.template"
+	spanAttributeStatusCode  = "http.response.status_code"
+	spanAttributeResendCount = "http.request.resend_count"
+	spanAttributeKind        = "meshstack.kind"
+)
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) Inject(context.Context, http.Header) {
+	// do nothing
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, any) {
+	// do nothing
+}
+
+func (noopSpan) SetError(error) {
+	// do nothing
+}
+
+func (noopSpan) End() {
+	// do nothing
+}
+
+type spanContextKey struct{}
+
+// startRequestSpan starts the span of a DoRequest, named after the method and path template like OpenTelemetry's HTTP client spans.
+// The span is kept in the returned context, so that the retryRoundTripper can record its attempts, see requestSpan.
+func startRequestSpan(ctx context.Context, method, pathTemplate, kind string) (context.Context, Span) {
+	ctx, span := Tracing.Start(ctx, method+" "+pathTemplate)
+	span.SetAttribute(spanAttributeMethod, method)
+	span.SetAttribute(spanAttributeUrlTemplate, pathTemplate)
+	if kind != "" {
+		span.SetAttribute(spanAttributeKind, kind)
+	}
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+// requestSpan returns the span started by startRequestSpan, or a no-op span for requests sent by other means.
+func requestSpan(ctx context.Context) Span {
+	if span, ok := ctx.Value(spanContextKey{}).(Span); ok {
+		return span
+	}
+	return noopSpan{}
+}
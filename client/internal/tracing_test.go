@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracing(t *testing.T) {
+	tracer := installTestTracer(t)
+	var attempts int
+	var traceparents []string
+	httpClient := WithRetry(newTestClientWithServer(t, func(resp http.ResponseWriter, req *http.Request) {
+		traceparents = append(traceparents, req.Header.Get("traceparent"))
+		attempts++
+		if attempts == 1 {
+			resp.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		resp.WriteHeader(http.StatusNotFound)
+	}), RetryOptions{MaxRetries: 3, Backoff: &retryTestBackoff{}})
+	httpClient.Authorization = BearerTokenAuthorization{Token: "some-token"}
+	client := NewMeshObjectClient[MeshTestObject](t.Context(), httpClient, []string{"v1"})
+
+	_, err := DoAuthorizedRequest[any](t.Context(), client.HttpClient, http.MethodGet, client.ApiUrl.JoinPath("some-uuid", "logs"), client.WithMeshObjectAccept(t.Context()))
+	require.Error(t, err)
+
+	require.Len(t, tracer.Spans, 1)
+	span := tracer.Spans[0]
+	assert.Equal(t, "GET /api/meshobjects/meshtestobjects/{id}/logs", span.Name)
+	assert.Equal(t, map[string]any{
+		"http.request.method":       "GET",
+		"url.template":              "/api/meshobjects/meshtestobjects/{id}/logs",
+		"meshstack.kind":            "meshTestObject",
+		"http.request.resend_count": 1,
+		"http.response.status_code": http.StatusNotFound,
+	}, span.Attributes)
+	assert.Error(t, span.Err)
+	assert.True(t, span.Ended)
+	assert.Equal(t, []string{"span-1", "span-1"}, traceparents, "every attempt propagates the span")
+}
+
+func TestTracedMeshObject_PathTemplate(t *testing.T) {
+	traced := tracedMeshObject{Kind: "meshProjectUserBinding", CollectionPath: "/api/meshobjects/meshprojectbindings/userbindings"}
+	assert.Equal(t, "/api/meshobjects/meshprojectbindings/userbindings", traced.PathTemplate("/api/meshobjects/meshprojectbindings/userbindings"))
+	assert.Equal(t, "/api/meshobjects/meshprojectbindings/userbindings/{id}", traced.PathTemplate("/api/meshobjects/meshprojectbindings/userbindings/some-binding"))
+	assert.Equal(t, "/api/meshobjects/meshprojectbindings/userbindings/{id}/purge", traced.PathTemplate("/api/meshobjects/meshprojectbindings/userbindings/some-binding/purge"))
+	assert.Equal(t, "/api/login", traced.PathTemplate("/api/login"))
+	assert.Equal(t, "/api/login", tracedMeshObject{}.PathTemplate("/api/login"))
+}
+
+func installTestTracer(t *testing.T) *testTracer {
+	t.Helper()
+	tracer := &testTracer{}
+	previousTracing := Tracing
+	Tracing = tracer
+	t.Cleanup(func() {
+		Tracing = previousTracing
+	})
+	return tracer
+}
+
+type testTracer struct {
+	mu    sync.Mutex
+	Spans []*testSpan
+}
+
+type testSpan struct {
+	Name       string
+	Attributes map[string]any
+	Err        error
+	Ended      bool
+}
+
+type testSpanContextKey struct{}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	span := &testSpan{Name: name, Attributes: map[string]any{}}
+	t.Spans = append(t.Spans, span)
+	return context.WithValue(ctx, testSpanContextKey{}, len(t.Spans)), span
+}
+
+func (t *testTracer) Inject(ctx context.Context, header http.Header) {
+	if spanNumber, ok := ctx.Value(testSpanContextKey{}).(int); ok {
+		header.Set("traceparent", fmt.Sprintf("span-%d", spanNumber))
+	}
+}
+
+func (s *testSpan) SetAttribute(key string, value any) {
+	s.Attributes[key] = value
+}
+
+func (s *testSpan) SetError(err error) {
+	s.Err = err
+}
+
+func (s *testSpan) End() {
+	s.Ended = true
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportOptions configure WithTransport. The zero value keeps http.DefaultTransport.
+type TransportOptions struct {
+	// CaCertPem are PEM-encoded CA certificates trusted in addition to the system's, e.g. of a TLS-intercepting proxy.
+	CaCertPem []byte
+	// ClientCertPem and ClientKeyPem are the PEM-encoded client certificate and key for mutual TLS.
+	ClientCertPem, ClientKeyPem []byte
+	// ProxyUrl is used for all requests instead of the proxy from the HTTP_PROXY/HTTPS_PROXY environment variables.
+	ProxyUrl *url.URL
+	// InsecureSkipVerify disables verification of the server certificate, for development only.
+	InsecureSkipVerify bool
+}
+
+func (o TransportOptions) isZero() bool {
+	return len(o.CaCertPem) == 0 && len(o.ClientCertPem) == 0 && len(o.ClientKeyPem) == 0 && o.ProxyUrl == nil && !o.InsecureSkipVerify
+}
+
+// WithTransport sets up the given client with a dedicated http.Transport built from options.
+// It must be applied first, as all other layers (such as WithRetry) wrap the transport.
+func WithTransport(c HttpClient, options TransportOptions) (HttpClient, error) {
+	if options.isZero() {
+		return c, nil
+	}
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return c, fmt.Errorf("default transport is %T, not *http.Transport", http.DefaultTransport)
+	}
+	transport := defaultTransport.Clone()
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.InsecureSkipVerify, //nolint:gosec // explicitly opted in, for development only
+	}
+	if len(options.CaCertPem) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			// Not available on every platform, trust the given certificates only then.
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(options.CaCertPem) {
+			return c, errors.New("no PEM-encoded certificate found in CA certificates")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	if len(options.ClientCertPem) > 0 || len(options.ClientKeyPem) > 0 {
+		clientCert, err := tls.X509KeyPair(options.ClientCertPem, options.ClientKeyPem)
+		if err != nil {
+			return c, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	transport.TLSClientConfig = tlsConfig
+	if options.ProxyUrl != nil {
+		transport.Proxy = http.ProxyURL(options.ProxyUrl)
+	}
+	c.Transport = transport
+	return c, nil
+}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTransport(t *testing.T) {
+	newServer := func(t *testing.T, clientCAs *x509.CertPool) (server *httptest.Server, caCertPem []byte) {
+		t.Helper()
+		server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		if clientCAs != nil {
+			server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+		}
+		server.StartTLS()
+		t.Cleanup(server.Close)
+		return server, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	}
+	doRequest := func(t *testing.T, server *httptest.Server, options TransportOptions) error {
+		t.Helper()
+		rootUrl, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		client, err := WithTransport(NewHttpClient(rootUrl, "test", nil), options)
+		require.NoError(t, err)
+		_, err = DoRequest[any](t.Context(), client, http.MethodGet, rootUrl)
+		return err
+	}
+
+	t.Run("trusts given CA certificates", func(t *testing.T) {
+		server, caCertPem := newServer(t, nil)
+		require.ErrorContains(t, doRequest(t, server, TransportOptions{}), "certificate signed by unknown authority")
+		assert.NoError(t, doRequest(t, server, TransportOptions{CaCertPem: caCertPem}))
+		assert.NoError(t, doRequest(t, server, TransportOptions{InsecureSkipVerify: true}))
+	})
+
+	t.Run("presents client certificate", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "terraform"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		certDer, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(certDer)
+		require.NoError(t, err)
+		keyDer, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(cert)
+
+		server, caCertPem := newServer(t, clientCAs)
+		assert.Error(t, doRequest(t, server, TransportOptions{CaCertPem: caCertPem}))
+		assert.NoError(t, doRequest(t, server, TransportOptions{
+			CaCertPem:     caCertPem,
+			ClientCertPem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDer}),
+			ClientKeyPem:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+		}))
+	})
+
+	t.Run("rejects invalid PEM", func(t *testing.T) {
+		_, err := WithTransport(HttpClient{Client: &http.Client{}}, TransportOptions{CaCertPem: []byte("not a certificate")})
+		assert.ErrorContains(t, err, "no PEM-encoded certificate found")
+		_, err = WithTransport(HttpClient{Client: &http.Client{}}, TransportOptions{ClientCertPem: []byte("not a certificate")})
+		assert.ErrorContains(t, err, "invalid client certificate or key")
+	})
+}
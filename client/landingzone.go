@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -75,8 +76,15 @@ type MeshLandingZoneListQuery struct {
 type MeshLandingZoneClient interface {
 	Read(ctx context.Context, name string) (*MeshLandingZone, error)
 	List(ctx context.Context, query MeshLandingZoneListQuery) ([]MeshLandingZone, error)
+	// All streams the landing zones List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, query MeshLandingZoneListQuery) iter.Seq2[MeshLandingZone, error]
 	Create(ctx context.Context, landingZone *MeshLandingZoneCreate) (*MeshLandingZone, error)
 	Update(ctx context.Context, name string, landingZone *MeshLandingZoneCreate) (*MeshLandingZone, error)
+	// ReadWithETag is Read, additionally returning the ETag of the landing zone for UpdateIfMatch.
+	ReadWithETag(ctx context.Context, name string) (*MeshLandingZone, string, error)
+	// UpdateIfMatch is Update, failing with HttpError.IsPreconditionFailed if the landing zone changed since
+	// it was read with the given ETag. An empty ETag updates unconditionally. It returns the new ETag.
+	UpdateIfMatch(ctx context.Context, name, etag string, landingZone *MeshLandingZoneCreate) (*MeshLandingZone, string, error)
 	Delete(ctx context.Context, name string) error
 }
 
@@ -85,7 +93,7 @@ type meshLandingZoneClient struct {
 }
 
 func newLandingZoneClient(ctx context.Context, httpClient internal.HttpClient) MeshLandingZoneClient {
-	return meshLandingZoneClient{internal.NewMeshObjectClient[MeshLandingZone](ctx, httpClient, "v1")}
+	return meshLandingZoneClient{internal.NewMeshObjectClient[MeshLandingZone](ctx, httpClient, []string{"v1"})}
 }
 
 func (c meshLandingZoneClient) Read(ctx context.Context, name string) (*MeshLandingZone, error) {
@@ -93,7 +101,11 @@ func (c meshLandingZoneClient) Read(ctx context.Context, name string) (*MeshLand
 }
 
 func (c meshLandingZoneClient) List(ctx context.Context, query MeshLandingZoneListQuery) ([]MeshLandingZone, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(query))
+	return internal.Collect(c.All(ctx, query))
+}
+
+func (c meshLandingZoneClient) All(ctx context.Context, query MeshLandingZoneListQuery) iter.Seq2[MeshLandingZone, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(query))
 }
 
 func (c meshLandingZoneClient) Create(ctx context.Context, landingZone *MeshLandingZoneCreate) (*MeshLandingZone, error) {
@@ -104,6 +116,16 @@ func (c meshLandingZoneClient) Update(ctx context.Context, name string, landingZ
 	return c.meshObject.Put(ctx, name, landingZone)
 }
 
+func (c meshLandingZoneClient) ReadWithETag(ctx context.Context, name string) (landingZone *MeshLandingZone, etag string, err error) {
+	landingZone, err = c.meshObject.Get(ctx, name, internal.WithResponseETag(&etag))
+	return
+}
+
+func (c meshLandingZoneClient) UpdateIfMatch(ctx context.Context, name, etag string, landingZone *MeshLandingZoneCreate) (updated *MeshLandingZone, newETag string, err error) {
+	updated, err = c.meshObject.Put(ctx, name, landingZone, internal.WithIfMatch(etag), internal.WithResponseETag(&newETag))
+	return
+}
+
 func (c meshLandingZoneClient) Delete(ctx context.Context, name string) error {
 	return c.meshObject.Delete(ctx, name)
 }
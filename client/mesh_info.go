@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -28,14 +29,36 @@ type MeshInfoClient interface {
 
 type meshInfoClient struct {
 	httpClient internal.HttpClient
+	// cached is the successfully read MeshInfo, which does not change during a run but is needed by
+	// the version check and the plan of every resource with feature gates.
+	cached *meshInfoCache
+}
+
+type meshInfoCache struct {
+	mu   sync.Mutex
+	info *MeshInfo
 }
 
 func newMeshInfoClient(httpClient internal.HttpClient) MeshInfoClient {
-	return meshInfoClient{httpClient: httpClient}
+	return meshInfoClient{httpClient: httpClient, cached: &meshInfoCache{}}
 }
 
 func (c meshInfoClient) Read(ctx context.Context) (*MeshInfo, error) {
-	meshInfoEndpoint := c.httpClient.RootUrl.JoinPath("/mesh/info")
+	c.cached.mu.Lock()
+	defer c.cached.mu.Unlock()
+	if c.cached.info == nil {
+		info, err := c.read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.cached.info = info
+	}
+	info := *c.cached.info
+	return &info, nil
+}
+
+func (c meshInfoClient) read(ctx context.Context) (*MeshInfo, error) {
+	meshInfoEndpoint := c.httpClient.RootUrl.JoinPath(meshInfoPath)
 	info, err := internal.DoRequest[MeshInfo](ctx, c.httpClient, "GET", meshInfoEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve meshStack instance information from %s endpoint: %w", meshInfoEndpoint, err)
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
+)
+
+// MeshObjectClient manages meshObjects of any kind and API version as plain JSON, e.g. of kinds without
+// a typed client yet. The kind and API version are passed with every call, as in meshProjectRole and v1.
+// The identifier is the last element of the meshObject's API path, usually its metadata.name or metadata.uuid.
+//
+// List passes query as URL query parameters, e.g. the filters of the kind such as workspaceIdentifier.
+// The JSON payload of Create and Update is sent with apiVersion and kind set to the given ones.
+type MeshObjectClient interface {
+	Read(ctx context.Context, kind, apiVersion, identifier string) (json.RawMessage, error)
+	Create(ctx context.Context, kind, apiVersion string, payload json.RawMessage) (json.RawMessage, error)
+	Update(ctx context.Context, kind, apiVersion, identifier string, payload json.RawMessage) (json.RawMessage, error)
+	Delete(ctx context.Context, kind, apiVersion, identifier string) error
+	List(ctx context.Context, kind, apiVersion string, query map[string]string) ([]json.RawMessage, error)
+	// All streams the meshObjects List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, kind, apiVersion string, query map[string]string) iter.Seq2[json.RawMessage, error]
+}
+
+type meshObjectClient struct {
+	httpClient internal.HttpClient
+}
+
+func newMeshObjectClient(httpClient internal.HttpClient) MeshObjectClient {
+	return meshObjectClient{httpClient}
+}
+
+func (c meshObjectClient) Read(ctx context.Context, kind, apiVersion, identifier string) (json.RawMessage, error) {
+	meshObject, err := internal.NewMeshObjectClientOfKind[json.RawMessage](c.httpClient, kind, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	object, err := meshObject.Get(ctx, identifier)
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return *object, nil
+}
+
+func (c meshObjectClient) Create(ctx context.Context, kind, apiVersion string, payload json.RawMessage) (json.RawMessage, error) {
+	meshObject, err := internal.NewMeshObjectClientOfKind[json.RawMessage](c.httpClient, kind, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	created, err := meshObject.Post(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	return *created, nil
+}
+
+func (c meshObjectClient) Update(ctx context.Context, kind, apiVersion, identifier string, payload json.RawMessage) (json.RawMessage, error) {
+	meshObject, err := internal.NewMeshObjectClientOfKind[json.RawMessage](c.httpClient, kind, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := meshObject.Put(ctx, identifier, payload)
+	if err != nil {
+		return nil, err
+	}
+	return *updated, nil
+}
+
+func (c meshObjectClient) Delete(ctx context.Context, kind, apiVersion, identifier string) error {
+	meshObject, err := internal.NewMeshObjectClientOfKind[json.RawMessage](c.httpClient, kind, apiVersion)
+	if err != nil {
+		return err
+	}
+	return meshObject.Delete(ctx, identifier)
+}
+
+func (c meshObjectClient) List(ctx context.Context, kind, apiVersion string, query map[string]string) ([]json.RawMessage, error) {
+	return internal.Collect(c.All(ctx, kind, apiVersion, query))
+}
+
+func (c meshObjectClient) All(ctx context.Context, kind, apiVersion string, query map[string]string) iter.Seq2[json.RawMessage, error] {
+	meshObject, err := internal.NewMeshObjectClientOfKind[json.RawMessage](c.httpClient, kind, apiVersion)
+	if err != nil {
+		return func(yield func(json.RawMessage, error) bool) { yield(nil, err) }
+	}
+	return meshObject.All(ctx, internal.WithUrlQuery(query))
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -94,6 +95,8 @@ type MeshPlatformListQuery struct {
 type MeshPlatformClient interface {
 	Read(ctx context.Context, uuid string) (*MeshPlatform, error)
 	List(ctx context.Context, query MeshPlatformListQuery) ([]MeshPlatform, error)
+	// All streams the platforms List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, query MeshPlatformListQuery) iter.Seq2[MeshPlatform, error]
 	Create(ctx context.Context, platform MeshPlatform) (*MeshPlatform, error)
 	Update(ctx context.Context, uuid string, platform MeshPlatform) (*MeshPlatform, error)
 	Delete(ctx context.Context, uuid string) error
@@ -104,7 +107,7 @@ type meshPlatformClient struct {
 }
 
 func newPlatformClient(ctx context.Context, httpClient internal.HttpClient) MeshPlatformClient {
-	return meshPlatformClient{internal.NewMeshObjectClient[MeshPlatform](ctx, httpClient, "v2")}
+	return meshPlatformClient{internal.NewMeshObjectClient[MeshPlatform](ctx, httpClient, []string{"v2"})}
 }
 
 func (c meshPlatformClient) Read(ctx context.Context, uuid string) (*MeshPlatform, error) {
@@ -112,7 +115,11 @@ func (c meshPlatformClient) Read(ctx context.Context, uuid string) (*MeshPlatfor
 }
 
 func (c meshPlatformClient) List(ctx context.Context, query MeshPlatformListQuery) ([]MeshPlatform, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(query))
+	return internal.Collect(c.All(ctx, query))
+}
+
+func (c meshPlatformClient) All(ctx context.Context, query MeshPlatformListQuery) iter.Seq2[MeshPlatform, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(query))
 }
 
 func (c meshPlatformClient) Create(ctx context.Context, platform MeshPlatform) (*MeshPlatform, error) {
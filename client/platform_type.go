@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -49,6 +50,8 @@ type MeshPlatformTypeClient interface {
 	Update(ctx context.Context, name string, platformType *MeshPlatformTypeCreate) (*MeshPlatformType, error)
 	Delete(ctx context.Context, name string) error
 	List(ctx context.Context, category *string, lifecycleStatus *string) ([]MeshPlatformType, error)
+	// All streams the platform types List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, category *string, lifecycleStatus *string) iter.Seq2[MeshPlatformType, error]
 }
 
 type meshPlatformTypeClient struct {
@@ -56,7 +59,7 @@ type meshPlatformTypeClient struct {
 }
 
 func newPlatformTypeClient(ctx context.Context, httpClient internal.HttpClient) MeshPlatformTypeClient {
-	return meshPlatformTypeClient{internal.NewMeshObjectClient[MeshPlatformType](ctx, httpClient, "v1")}
+	return meshPlatformTypeClient{internal.NewMeshObjectClient[MeshPlatformType](ctx, httpClient, []string{"v1"})}
 }
 
 func (c meshPlatformTypeClient) Create(ctx context.Context, platformType *MeshPlatformTypeCreate) (*MeshPlatformType, error) {
@@ -81,7 +84,11 @@ type meshPlatformTypeListQuery struct {
 }
 
 func (c meshPlatformTypeClient) List(ctx context.Context, category *string, lifecycleStatus *string) ([]MeshPlatformType, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(meshPlatformTypeListQuery{
+	return internal.Collect(c.All(ctx, category, lifecycleStatus))
+}
+
+func (c meshPlatformTypeClient) All(ctx context.Context, category *string, lifecycleStatus *string) iter.Seq2[MeshPlatformType, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(meshPlatformTypeListQuery{
 		Category:        category,
 		LifecycleStatus: lifecycleStatus,
 	}))
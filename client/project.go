@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -38,6 +39,8 @@ type MeshProjectCreateMetadata struct {
 type MeshProjectClient interface {
 	Read(ctx context.Context, workspace string, name string) (*MeshProject, error)
 	List(ctx context.Context, workspaceIdentifier string, paymentMethodIdentifier *string) ([]MeshProject, error)
+	// All streams the projects List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, workspaceIdentifier string, paymentMethodIdentifier *string) iter.Seq2[MeshProject, error]
 	Create(ctx context.Context, project *MeshProjectCreate) (*MeshProject, error)
 	Update(ctx context.Context, project *MeshProjectCreate) (*MeshProject, error)
 	Delete(ctx context.Context, workspace string, name string) error
@@ -48,7 +51,7 @@ type meshProjectClient struct {
 }
 
 func newProjectClient(ctx context.Context, httpClient internal.HttpClient) MeshProjectClient {
-	return meshProjectClient{internal.NewMeshObjectClient[MeshProject](ctx, httpClient, "v2")}
+	return meshProjectClient{internal.NewMeshObjectClient[MeshProject](ctx, httpClient, []string{"v2"})}
 }
 
 func (c meshProjectClient) projectId(workspace string, name string) string {
@@ -65,7 +68,11 @@ type meshProjectListQuery struct {
 }
 
 func (c meshProjectClient) List(ctx context.Context, workspaceIdentifier string, paymentMethodIdentifier *string) ([]MeshProject, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(meshProjectListQuery{
+	return internal.Collect(c.All(ctx, workspaceIdentifier, paymentMethodIdentifier))
+}
+
+func (c meshProjectClient) All(ctx context.Context, workspaceIdentifier string, paymentMethodIdentifier *string) iter.Seq2[MeshProject, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(meshProjectListQuery{
 		WorkspaceIdentifier: workspaceIdentifier,
 		PaymentIdentifier:   paymentMethodIdentifier,
 	}))
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -30,6 +31,8 @@ type MeshServiceInstanceSpec struct {
 type MeshServiceInstanceClient interface {
 	Read(ctx context.Context, instanceId string) (*MeshServiceInstance, error)
 	List(ctx context.Context, filter MeshServiceInstanceFilter) ([]MeshServiceInstance, error)
+	// All streams the service instances List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, filter MeshServiceInstanceFilter) iter.Seq2[MeshServiceInstance, error]
 }
 
 type meshServiceInstanceClient struct {
@@ -45,7 +48,7 @@ type MeshServiceInstanceFilter struct {
 }
 
 func newServiceInstanceClient(ctx context.Context, httpClient internal.HttpClient) MeshServiceInstanceClient {
-	return meshServiceInstanceClient{internal.NewMeshObjectClient[MeshServiceInstance](ctx, httpClient, "v2")}
+	return meshServiceInstanceClient{internal.NewMeshObjectClient[MeshServiceInstance](ctx, httpClient, []string{"v2"})}
 }
 
 func (c meshServiceInstanceClient) Read(ctx context.Context, instanceId string) (*MeshServiceInstance, error) {
@@ -53,5 +56,9 @@ func (c meshServiceInstanceClient) Read(ctx context.Context, instanceId string)
 }
 
 func (c meshServiceInstanceClient) List(ctx context.Context, filter MeshServiceInstanceFilter) ([]MeshServiceInstance, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(filter))
+	return internal.Collect(c.All(ctx, filter))
+}
+
+func (c meshServiceInstanceClient) All(ctx context.Context, filter MeshServiceInstanceFilter) iter.Seq2[MeshServiceInstance, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(filter))
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -69,6 +70,8 @@ type TagValueMultiSelect struct {
 
 type MeshTagDefinitionClient interface {
 	List(ctx context.Context) ([]MeshTagDefinition, error)
+	// All streams the tag definitions List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context) iter.Seq2[MeshTagDefinition, error]
 	Read(ctx context.Context, name string) (*MeshTagDefinition, error)
 	Create(ctx context.Context, tagDefinition *MeshTagDefinition) (*MeshTagDefinition, error)
 	Update(ctx context.Context, tagDefinition *MeshTagDefinition) (*MeshTagDefinition, error)
@@ -80,11 +83,15 @@ type meshTagDefinitionClient struct {
 }
 
 func newTagDefinitionClient(ctx context.Context, httpClient internal.HttpClient) MeshTagDefinitionClient {
-	return meshTagDefinitionClient{internal.NewMeshObjectClient[MeshTagDefinition](ctx, httpClient, "v1")}
+	return meshTagDefinitionClient{internal.NewMeshObjectClient[MeshTagDefinition](ctx, httpClient, []string{"v1"})}
 }
 
 func (c meshTagDefinitionClient) List(ctx context.Context) ([]MeshTagDefinition, error) {
-	return c.meshObject.List(ctx)
+	return internal.Collect(c.All(ctx))
+}
+
+func (c meshTagDefinitionClient) All(ctx context.Context) iter.Seq2[MeshTagDefinition, error] {
+	return c.meshObject.All(ctx)
 }
 
 func (c meshTagDefinitionClient) Read(ctx context.Context, name string) (*MeshTagDefinition, error) {
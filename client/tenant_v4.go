@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
+	"time"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 	"github.com/meshcloud/terraform-provider-meshstack/client/types/enum"
@@ -36,6 +38,7 @@ type MeshTenantMetadata struct {
 	Uuid             string `json:"uuid" tfsdk:"uuid"`
 	OwnedByProject   string `json:"ownedByProject" tfsdk:"owned_by_project"`
 	OwnedByWorkspace string `json:"ownedByWorkspace" tfsdk:"owned_by_workspace"`
+	CreatedOn        string `json:"createdOn,omitempty" tfsdk:"-"`
 }
 
 type MeshTenantSpec struct {
@@ -115,6 +118,8 @@ type MeshTenantClient interface {
 	Read(ctx context.Context, uuid string) (*MeshTenant, error)
 	ReadFunc(uuid string) func(ctx context.Context) (*MeshTenant, error)
 	List(ctx context.Context, query MeshTenantQuery) ([]MeshTenant, error)
+	// All streams the tenants List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context, query MeshTenantQuery) iter.Seq2[MeshTenant, error]
 	Create(ctx context.Context, tenant *MeshTenantCreate) (*MeshTenant, error)
 	Delete(ctx context.Context, uuid string) error
 }
@@ -124,7 +129,7 @@ type meshTenantClient struct {
 }
 
 func newTenantClient(ctx context.Context, httpClient internal.HttpClient) MeshTenantClient {
-	return meshTenantClient{internal.NewMeshObjectClient[MeshTenant](ctx, httpClient, "v4")}
+	return meshTenantClient{internal.NewMeshObjectClient[MeshTenant](ctx, httpClient, []string{"v4"})}
 }
 
 func (c meshTenantClient) Read(ctx context.Context, uuid string) (*MeshTenant, error) {
@@ -137,12 +142,28 @@ func (c meshTenantClient) ReadFunc(uuid string) func(ctx context.Context) (*Mesh
 	}
 }
 
+// Create is retried on e.g. a 503, see internal.MeshObjectClient.PostIdempotently. A tenant orphaned by
+// an earlier attempt is found by its project and platform, as a project has at most one tenant per platform.
 func (c meshTenantClient) Create(ctx context.Context, tenant *MeshTenantCreate) (*MeshTenant, error) {
-	return c.meshObject.Post(ctx, tenant)
+	return c.meshObject.PostIdempotently(ctx, tenant, func(ctx context.Context, firstAttempt time.Time) (*MeshTenant, error) {
+		candidates, err := c.List(ctx, MeshTenantQuery{Workspace: tenant.Metadata.OwnedByWorkspace, Project: &tenant.Metadata.OwnedByProject})
+		if err != nil {
+			return nil, err
+		}
+		return onlyOrphan(candidates, func(candidate MeshTenant) bool {
+			return candidate.Spec.PlatformRef.Uuid == tenant.Spec.PlatformRef.Uuid &&
+				candidate.Status.Lifecycle.State != TenantLifecycleStateDeleted &&
+				createdAfter(candidate.Metadata.CreatedOn, firstAttempt)
+		}), nil
+	})
 }
 
 func (c meshTenantClient) List(ctx context.Context, query MeshTenantQuery) ([]MeshTenant, error) {
-	return c.meshObject.List(ctx, internal.WithUrlQuery(query))
+	return internal.Collect(c.All(ctx, query))
+}
+
+func (c meshTenantClient) All(ctx context.Context, query MeshTenantQuery) iter.Seq2[MeshTenant, error] {
+	return c.meshObject.All(ctx, internal.WithUrlQuery(query))
 }
 
 func (c meshTenantClient) Delete(ctx context.Context, uuid string) error {
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
+)
+
+func TestMeshTenantClient_Create_AdoptsOnlyOrphans(t *testing.T) {
+	const platformUuid = "33333333-3333-3333-3333-333333333333"
+	tenantCreatedOn := func(createdOn time.Time) MeshTenant {
+		return MeshTenant{
+			Metadata: MeshTenantMetadata{
+				Uuid:             "tenant-uuid",
+				OwnedByWorkspace: "my-workspace",
+				OwnedByProject:   "my-project",
+				CreatedOn:        createdOn.UTC().Format(time.RFC3339),
+			},
+			Spec: MeshTenantSpec{PlatformRef: UuidRef{Uuid: platformUuid}},
+		}
+	}
+	// newClient answers the first create attempt with a 503 and the retried one with a 409, listing a
+	// tenant with the same platform as the only one of the project. Unless it existed before, that tenant
+	// was created by the first attempt. The clock of the server is skew ahead of the client's.
+	newClient := func(t *testing.T, existedBefore bool, skew time.Duration) MeshTenantClient {
+		t.Helper()
+		serverNow := func() time.Time { return time.Now().Add(skew) }
+		existing := tenantCreatedOn(serverNow().Add(-time.Hour))
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Date", serverNow().UTC().Format(http.TimeFormat))
+			if r.Method == http.MethodPost {
+				attempts++
+				if attempts == 1 {
+					if !existedBefore {
+						existing = tenantCreatedOn(serverNow())
+					}
+					w.WriteHeader(http.StatusServiceUnavailable)
+				} else {
+					w.WriteHeader(http.StatusConflict)
+				}
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"_embedded": map[string]any{"meshTenants": []MeshTenant{existing}},
+				"page":      map[string]any{"totalPages": 1, "number": 0},
+			})
+		}))
+		t.Cleanup(server.Close)
+		rootUrl, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		httpClient := internal.WithRetry(internal.NewHttpClient(rootUrl, "test-agent", NewApiTokenAuthorization("token")), internal.RetryOptions{
+			MaxRetries: 3,
+			Backoff:    internal.ExponentialBackoff{MinWait: time.Millisecond, MaxWait: time.Millisecond},
+		})
+		return newTenantClient(t.Context(), httpClient)
+	}
+	create := &MeshTenantCreate{
+		Metadata: MeshTenantCreateMetadata{OwnedByWorkspace: "my-workspace", OwnedByProject: "my-project"},
+		Spec:     MeshTenantCreateSpec{PlatformRef: UuidRef{Uuid: platformUuid}},
+	}
+
+	for name, skew := range map[string]time.Duration{
+		"same clock":          0,
+		"server clock behind": -2 * time.Hour,
+		"server clock ahead":  2 * time.Hour,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Run("adopts the tenant created by the first attempt", func(t *testing.T) {
+				tenant, err := newClient(t, false, skew).Create(t.Context(), create)
+				require.NoError(t, err)
+				assert.Equal(t, "tenant-uuid", tenant.Metadata.Uuid)
+			})
+
+			t.Run("never adopts a tenant that existed before", func(t *testing.T) {
+				_, err := newClient(t, true, skew).Create(t.Context(), create)
+				require.ErrorContains(t, err, "http error 409")
+			})
+		})
+	}
+}
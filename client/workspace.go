@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client/internal"
 )
@@ -34,8 +35,16 @@ type MeshWorkspaceCreateMetadata struct {
 
 type MeshWorkspaceClient interface {
 	Read(ctx context.Context, name string) (*MeshWorkspace, error)
+	List(ctx context.Context) ([]MeshWorkspace, error)
+	// All streams the workspaces List would return, page by page. Stop iterating to stop fetching pages.
+	All(ctx context.Context) iter.Seq2[MeshWorkspace, error]
 	Create(ctx context.Context, workspace *MeshWorkspaceCreate) (*MeshWorkspace, error)
 	Update(ctx context.Context, name string, workspace *MeshWorkspaceCreate) (*MeshWorkspace, error)
+	// ReadWithETag is Read, additionally returning the ETag of the workspace for UpdateIfMatch.
+	ReadWithETag(ctx context.Context, name string) (*MeshWorkspace, string, error)
+	// UpdateIfMatch is Update, failing with HttpError.IsPreconditionFailed if the workspace changed since it
+	// was read with the given ETag. An empty ETag updates unconditionally. It returns the new ETag.
+	UpdateIfMatch(ctx context.Context, name, etag string, workspace *MeshWorkspaceCreate) (*MeshWorkspace, string, error)
 	Delete(ctx context.Context, name string) error
 }
 
@@ -44,13 +53,21 @@ type meshWorkspaceClient struct {
 }
 
 func newWorkspaceClient(ctx context.Context, httpClient internal.HttpClient) meshWorkspaceClient {
-	return meshWorkspaceClient{internal.NewMeshObjectClient[MeshWorkspace](ctx, httpClient, "v2")}
+	return meshWorkspaceClient{internal.NewMeshObjectClient[MeshWorkspace](ctx, httpClient, []string{"v2"})}
 }
 
 func (c meshWorkspaceClient) Read(ctx context.Context, name string) (*MeshWorkspace, error) {
 	return c.meshObject.Get(ctx, name)
 }
 
+func (c meshWorkspaceClient) List(ctx context.Context) ([]MeshWorkspace, error) {
+	return internal.Collect(c.All(ctx))
+}
+
+func (c meshWorkspaceClient) All(ctx context.Context) iter.Seq2[MeshWorkspace, error] {
+	return c.meshObject.All(ctx)
+}
+
 func (c meshWorkspaceClient) Create(ctx context.Context, workspace *MeshWorkspaceCreate) (*MeshWorkspace, error) {
 	return c.meshObject.Post(ctx, workspace)
 }
@@ -59,6 +76,16 @@ func (c meshWorkspaceClient) Update(ctx context.Context, name string, workspace
 	return c.meshObject.Put(ctx, name, workspace)
 }
 
+func (c meshWorkspaceClient) ReadWithETag(ctx context.Context, name string) (workspace *MeshWorkspace, etag string, err error) {
+	workspace, err = c.meshObject.Get(ctx, name, internal.WithResponseETag(&etag))
+	return
+}
+
+func (c meshWorkspaceClient) UpdateIfMatch(ctx context.Context, name, etag string, workspace *MeshWorkspaceCreate) (updated *MeshWorkspace, newETag string, err error) {
+	updated, err = c.meshObject.Put(ctx, name, workspace, internal.WithIfMatch(etag), internal.WithResponseETag(&newETag))
+	return
+}
+
 func (c meshWorkspaceClient) Delete(ctx context.Context, name string) error {
 	return c.meshObject.Delete(ctx, name)
 }
@@ -0,0 +1,24 @@
+# Rerun on demand:
+#   terraform apply -invoke=action.meshstack_building_block_run.example
+action "meshstack_building_block_run" "example" {
+  config {
+    building_block_uuid = meshstack_building_block.example.metadata.uuid
+    timeout             = "1h"
+  }
+}
+
+# Or rerun whenever another resource changes, e.g. a credential the building block reads.
+variable "credential_version" {
+  type = string
+}
+
+resource "terraform_data" "credential_rotation" {
+  input = var.credential_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.meshstack_building_block_run.example]
+    }
+  }
+}
@@ -0,0 +1,13 @@
+data "meshstack_meshobjects" "project_roles" {
+  kind        = "meshProjectRole"
+  api_version = "v1"
+
+  # optional filtering, the available filters depend on the kind
+  # query = {
+  #   name = "reader"
+  # }
+}
+
+output "project_role_names" {
+  value = [for role in data.meshstack_meshobjects.project_roles.items : role.metadata.name]
+}
@@ -0,0 +1,21 @@
+variable "api_key_client_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+ephemeral "meshstack_access_token" "example" {
+  client_id     = meshstack_api_key.example.status.client_id
+  client_secret = var.api_key_client_secret
+}
+
+# Ephemeral values can only be used where Terraform does not store them,
+# e.g. in provider configurations, write-only attributes and provisioners.
+resource "terraform_data" "list_workspaces" {
+  provisioner "local-exec" {
+    command = "curl --fail -H \"Authorization: Bearer $MESHSTACK_TOKEN\" -H 'Accept: application/vnd.meshcloud.api.meshWorkspace.v2.hal+json' https://federation.example.com/api/meshobjects/meshworkspaces"
+    environment = {
+      MESHSTACK_TOKEN = ephemeral.meshstack_access_token.example.token
+    }
+  }
+}
@@ -0,0 +1,8 @@
+list "meshstack_building_block" "example" {
+  provider = meshstack
+
+  config {
+    workspace_identifier = "my-workspace"
+    definition_uuid      = "00000000-0000-0000-0000-000000000000"
+  }
+}
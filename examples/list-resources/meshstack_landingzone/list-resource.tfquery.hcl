@@ -0,0 +1,7 @@
+list "meshstack_landingzone" "example" {
+  provider = meshstack
+
+  config {
+    owned_by_workspace = "my-workspace"
+  }
+}
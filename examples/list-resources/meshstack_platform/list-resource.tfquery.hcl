@@ -0,0 +1,7 @@
+list "meshstack_platform" "example" {
+  provider = meshstack
+
+  config {
+    owned_by_workspace = "my-workspace"
+  }
+}
@@ -0,0 +1,7 @@
+list "meshstack_project" "example" {
+  provider = meshstack
+
+  config {
+    workspace_identifier = "my-workspace"
+  }
+}
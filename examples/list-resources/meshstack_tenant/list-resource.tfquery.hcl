@@ -0,0 +1,8 @@
+list "meshstack_tenant" "aws" {
+  provider = meshstack
+
+  config {
+    workspace_identifier     = "my-workspace"
+    platform_type_identifier = "AWS"
+  }
+}
@@ -0,0 +1,3 @@
+list "meshstack_workspace" "all" {
+  provider = meshstack
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_building_block.example_workspace
+  identity = {
+    uuid = "b1e74c3e-5953-4450-9a1b-e4a5e87e2d36"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_building_block_definition.example_01_terraform
+  identity = {
+    uuid = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_integration.example_github
+  identity = {
+    uuid = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_landingzone.example
+  identity = {
+    name = "my-landingzone-identifier"
+  }
+}
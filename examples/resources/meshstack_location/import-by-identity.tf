@@ -0,0 +1,6 @@
+import {
+  to = meshstack_location.example
+  identity = {
+    name = "my-location"
+  }
+}
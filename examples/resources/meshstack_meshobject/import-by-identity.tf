@@ -0,0 +1,8 @@
+import {
+  to = meshstack_meshobject.example
+  identity = {
+    kind        = "meshProjectRole"
+    api_version = "v1"
+    identifier  = "auditor"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  id = "meshProjectRole/v1/auditor" # <kind>/<api_version>/<identifier>
+  to = meshstack_meshobject.example
+}
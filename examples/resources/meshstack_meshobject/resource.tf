@@ -0,0 +1,19 @@
+resource "meshstack_meshobject" "example" {
+  api_version = "v1"
+  kind        = "meshProjectRole"
+  identifier  = "auditor"
+
+  manifest = jsonencode({
+    metadata = {
+      name = "auditor"
+    }
+    spec = {
+      displayName = "Auditor"
+      description = "Read-only access for audits"
+    }
+  })
+}
+
+output "project_role_status" {
+  value = jsondecode(meshstack_meshobject.example.object).status
+}
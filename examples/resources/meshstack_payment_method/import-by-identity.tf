@@ -0,0 +1,7 @@
+import {
+  to = meshstack_payment_method.example
+  identity = {
+    owned_by_workspace = "my-workspace"
+    name               = "my-payment-method"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_platform.example_azure
+  identity = {
+    uuid = "09631015-0f06-4f6a-b459-03047fbd89d1"
+  }
+}
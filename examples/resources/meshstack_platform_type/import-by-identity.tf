@@ -0,0 +1,6 @@
+import {
+  to = meshstack_platform_type.example
+  identity = {
+    name = "MY-PLATFORM-TYPE"
+  }
+}
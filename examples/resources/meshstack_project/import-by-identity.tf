@@ -0,0 +1,7 @@
+import {
+  to = meshstack_project.example
+  identity = {
+    owned_by_workspace = "my-workspace"
+    name               = "my-project"
+  }
+}
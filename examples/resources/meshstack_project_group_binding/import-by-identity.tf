@@ -0,0 +1,8 @@
+import {
+  to = meshstack_project_group_binding.example
+  identity = {
+    workspace = "my-workspace"
+    project   = "my-project"
+    name      = "my-binding-name"
+  }
+}
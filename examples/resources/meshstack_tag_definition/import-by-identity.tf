@@ -0,0 +1,6 @@
+import {
+  to = meshstack_tag_definition.example
+  identity = {
+    name = "meshProject.example-key"
+  }
+}
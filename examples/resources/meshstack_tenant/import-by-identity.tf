@@ -0,0 +1,6 @@
+import {
+  to = meshstack_tenant.example
+  identity = {
+    uuid = "2f1c0a1e-7f35-4a8c-9d0b-5e6b3c4d2a10"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_workspace.example
+  identity = {
+    name = "my-workspace"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = meshstack_workspace_group_binding.example
+  identity = {
+    workspace = "my-workspace"
+    name      = "my-binding-name"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = meshstack_workspace_tag.example
+  identity = {
+    workspace_identifier = "my-workspace"
+    key                  = "cost-center"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meshstack_workspace_tags.example
+  identity = {
+    workspace_identifier = "my-workspace"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = meshstack_workspace_user_binding.example
+  identity = {
+    workspace = "my-workspace"
+    name      = "my-binding-name"
+  }
+}
@@ -0,0 +1,24 @@
+package clientmock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// MeshAccessTokenClient logs in with the API keys of the shared API key store. It does not check the
+// client secret, which the API key mock does not keep.
+type MeshAccessTokenClient struct {
+	ApiKeyStore *Store[client.MeshApiKey]
+}
+
+func (m MeshAccessTokenClient) Login(_ context.Context, clientId, _ string) (*client.AccessToken, error) {
+	if _, ok := m.ApiKeyStore.Get(clientId); !ok {
+		return nil, fmt.Errorf("login with client id '%s' failed: unknown api key", clientId)
+	}
+	return &client.AccessToken{Token: "token-" + uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
@@ -23,7 +23,9 @@ func (m MeshApiKeyClient) Create(_ context.Context, apiKey *client.MeshApiKey) (
 		Spec: apiKey.Spec,
 	}
 
-	m.Store.Set(apiKeyUuid, stored)
+	if err := m.Store.Set(apiKeyUuid, stored); err != nil {
+		return nil, err
+	}
 
 	created := *stored
 	created.Status = &client.MeshApiKeyStatus{ClientId: apiKeyUuid, ClientSecret: new("secret-" + uuid.NewString())}
@@ -48,6 +50,9 @@ func (m MeshApiKeyClient) Update(_ context.Context, uuid string, apiKey *client.
 	expiresAtChanged := (existing.Spec.ExpiresAt == nil) != (apiKey.Spec.ExpiresAt == nil) ||
 		(existing.Spec.ExpiresAt != nil && apiKey.Spec.ExpiresAt != nil && *existing.Spec.ExpiresAt != *apiKey.Spec.ExpiresAt)
 	existing.Spec = apiKey.Spec
+	if err := m.Store.Set(uuid, existing); err != nil {
+		return nil, err
+	}
 
 	result := *existing
 	result.Status = &client.MeshApiKeyStatus{ClientId: uuid}
@@ -62,6 +67,5 @@ func (m MeshApiKeyClient) Update(_ context.Context, uuid string, apiKey *client.
 }
 
 func (m MeshApiKeyClient) Delete(_ context.Context, uuid string) error {
-	m.Store.Delete(uuid)
-	return nil
+	return m.Store.Delete(uuid)
 }
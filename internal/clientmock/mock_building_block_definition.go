@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/google/uuid"
 
@@ -24,6 +25,10 @@ func (m meshBuildingBlockDefinitionClient) List(_ context.Context, workspaceIden
 	return result, nil
 }
 
+func (m meshBuildingBlockDefinitionClient) All(ctx context.Context, workspaceIdentifier *string) iter.Seq2[client.MeshBuildingBlockDefinition, error] {
+	return listAsSeq(m.List(ctx, workspaceIdentifier))
+}
+
 func (m meshBuildingBlockDefinitionClient) Read(_ context.Context, uuid string) (*client.MeshBuildingBlockDefinition, error) {
 	if def, ok := m.Store.Get(uuid); ok {
 		return def, nil
@@ -37,11 +42,13 @@ func (m meshBuildingBlockDefinitionClient) Create(_ context.Context, definition
 	if definition.Spec.Symbol == nil {
 		definition.Spec.Symbol = new("mock-default-symbol")
 	}
-	m.Store.Set(definitionUuid, &definition)
+	if err := m.Store.Set(definitionUuid, &definition); err != nil {
+		return nil, err
+	}
 
 	// Create initial empty version (as the backend does)
 	versionUuid := uuid.NewString()
-	m.StoreVersion.Set(versionUuid, &client.MeshBuildingBlockDefinitionVersion{
+	if err := m.StoreVersion.Set(versionUuid, &client.MeshBuildingBlockDefinitionVersion{
 		Metadata: client.MeshBuildingBlockDefinitionVersionMetadata{
 			Uuid:             versionUuid,
 			OwnedByWorkspace: definition.Metadata.OwnedByWorkspace,
@@ -54,8 +61,12 @@ func (m meshBuildingBlockDefinitionClient) Create(_ context.Context, definition
 			DeletionMode:  client.BuildingBlockDeletionModeDelete.Unwrap(),
 			VersionNumber: new(int64(1)),
 			State:         client.MeshBuildingBlockDefinitionVersionStateDraft.Ptr(),
+			// A version without implementation cannot be marshaled, e.g. into the offline state file.
+			Implementation: client.MeshBuildingBlockDefinitionImplementation{Manual: &client.MeshBuildingBlockDefinitionManualImplementation{}},
 		},
-	})
+	}); err != nil {
+		return nil, err
+	}
 	return &definition, nil
 }
 
@@ -63,12 +74,14 @@ func (m meshBuildingBlockDefinitionClient) Update(_ context.Context, uuid string
 	if existing, ok := m.Store.Get(uuid); ok {
 		existing.Spec = definition.Spec
 		existing.Metadata.Tags = definition.Metadata.Tags
+		if err := m.Store.Set(uuid, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("building block definition not found: %s", uuid)
 }
 
 func (m meshBuildingBlockDefinitionClient) Delete(_ context.Context, uuid string) error {
-	m.Store.Delete(uuid)
-	return nil
+	return m.Store.Delete(uuid)
 }
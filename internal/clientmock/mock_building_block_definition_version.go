@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 	"sort"
 
 	"github.com/google/uuid"
@@ -24,6 +25,10 @@ func (m meshBuildingBlockDefinitionVersionClient) List(_ context.Context, buildi
 	return result, nil
 }
 
+func (m meshBuildingBlockDefinitionVersionClient) All(ctx context.Context, buildingBlockDefinitionUuid string) iter.Seq2[client.MeshBuildingBlockDefinitionVersion, error] {
+	return listAsSeq(m.List(ctx, buildingBlockDefinitionUuid))
+}
+
 func (m meshBuildingBlockDefinitionVersionClient) Create(_ context.Context, ownedByWorkspace string, versionSpec client.MeshBuildingBlockDefinitionVersionSpec) (*client.MeshBuildingBlockDefinitionVersion, error) {
 	nextNum := m.getNextVersionNumber()
 	versionUuid := uuid.NewString()
@@ -47,7 +52,9 @@ func (m meshBuildingBlockDefinitionVersionClient) Create(_ context.Context, owne
 		Spec: versionSpec,
 	}
 
-	m.Store.Set(versionUuid, created)
+	if err := m.Store.Set(versionUuid, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -62,6 +69,9 @@ func (m meshBuildingBlockDefinitionVersionClient) Update(_ context.Context, uuid
 			return nil, fmt.Errorf("mismatching workspace ownership: %s (existing) != %s (expected)", existing.Metadata.OwnedByWorkspace, ownedByWorkspace)
 		}
 		existing.Spec = versionSpec
+		if err := m.Store.Set(uuid, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("building block definition version not found: %s", uuid)
@@ -35,7 +35,9 @@ func (m MeshBuildingBlockRunnerClient) Create(_ context.Context, runner client.M
 			WorkloadIdentityFederation: runner.Spec.WorkloadIdentityFederation,
 		},
 	}
-	m.Store.Set(runnerUuid, created)
+	if err := m.Store.Set(runnerUuid, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -62,6 +64,9 @@ func (m MeshBuildingBlockRunnerClient) Update(_ context.Context, runner client.M
 			isSelfHosted := true
 			existing.Spec.IsSelfHosted = new(isSelfHosted)
 		}
+		if err := m.Store.Set(uuid, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 
@@ -69,6 +74,5 @@ func (m MeshBuildingBlockRunnerClient) Update(_ context.Context, runner client.M
 }
 
 func (m MeshBuildingBlockRunnerClient) Delete(_ context.Context, uuid string) error {
-	m.Store.Delete(uuid)
-	return nil
+	return m.Store.Delete(uuid)
 }
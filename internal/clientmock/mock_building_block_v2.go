@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"reflect"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -115,6 +117,10 @@ func (m MeshBuildingBlockV2Client) List(_ context.Context, filter client.MeshBui
 	return result, nil
 }
 
+func (m MeshBuildingBlockV2Client) All(ctx context.Context, filter client.MeshBuildingBlockV2ListFilter) iter.Seq2[client.MeshBuildingBlockV2, error] {
+	return listAsSeq(m.List(ctx, filter))
+}
+
 // mockBuildingBlockMatchesFilter applies the subset of MeshBuildingBlockV2ListFilter fields that
 // are derivable from a stored building block. Fields the mock store doesn't carry — DefinitionUuid
 // and VersionNumber (the store only holds the definition *version* uuid, not the definition uuid or
@@ -170,6 +176,7 @@ func (m MeshBuildingBlockV2Client) Create(_ context.Context, bb *client.MeshBuil
 	stored.Metadata = client.MeshBuildingBlockV2Metadata{
 		Uuid:             &id,
 		OwnedByWorkspace: ownedByWorkspace,
+		CreatedOn:        time.Now().UTC().Format(time.RFC3339),
 	}
 	stored.Status = &client.MeshBuildingBlockV2Status{
 		Status:        client.BuildingBlockStatusSucceeded,
@@ -179,7 +186,9 @@ func (m MeshBuildingBlockV2Client) Create(_ context.Context, bb *client.MeshBuil
 		},
 	}
 
-	m.Store.Set(id, stored)
+	if err := m.Store.Set(id, stored); err != nil {
+		return nil, err
+	}
 	// Return a fresh deep copy so SetFromClientDto cannot mutate the store via the returned pointer.
 	return m.withDerivedParents(deepCopyBB(stored)), nil
 }
@@ -203,6 +212,8 @@ func (m MeshBuildingBlockV2Client) Update(_ context.Context, bb *client.MeshBuil
 	var existing *client.MeshBuildingBlockV2
 	if prior, ok := m.Store.Get(*stored.Metadata.Uuid); ok {
 		existing = deepCopyBB(prior)
+		// A PUT does not carry the read-only creation timestamp.
+		stored.Metadata.CreatedOn = existing.Metadata.CreatedOn
 	}
 
 	// Mirror the backend: a PUT patches only the inputs it carries, so inputs omitted from the request
@@ -251,13 +262,14 @@ func (m MeshBuildingBlockV2Client) Update(_ context.Context, bb *client.MeshBuil
 		}
 	}
 
-	m.Store.Set(*stored.Metadata.Uuid, stored)
+	if err := m.Store.Set(*stored.Metadata.Uuid, stored); err != nil {
+		return nil, err
+	}
 	return m.withDerivedParents(deepCopyBB(stored)), nil // fresh copy out (see Create)
 }
 
 func (m MeshBuildingBlockV2Client) Delete(_ context.Context, bbUuid string, purge bool) error {
-	m.Store.Delete(bbUuid)
-	return nil
+	return m.Store.Delete(bbUuid)
 }
 
 func (m MeshBuildingBlockV2Client) TriggerRun(_ context.Context, bbUuid string) error {
@@ -276,8 +288,7 @@ func (m MeshBuildingBlockV2Client) TriggerRun(_ context.Context, bbUuid string)
 	cp.Status.Status = client.BuildingBlockStatusSucceeded
 	cp.Status.LatestRunUuid = new(uuid.NewString())
 	cp.Status.LatestDryRunUuid = nil
-	m.Store.Set(bbUuid, cp)
-	return nil
+	return m.Store.Set(bbUuid, cp)
 }
 
 // provisioningChanged reports whether a PUT made a backend-visible change that triggers an apply run:
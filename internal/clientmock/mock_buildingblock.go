@@ -103,13 +103,14 @@ func (m meshBuildingBlockClient) Create(_ context.Context, bb *client.MeshBuildi
 		},
 	}
 
-	m.Store.Set(id, stored)
+	if err := m.Store.Set(id, stored); err != nil {
+		return nil, err
+	}
 	return m.toV1(deepCopyBB(stored))
 }
 
 func (m meshBuildingBlockClient) Delete(_ context.Context, id string) error {
-	m.Store.Delete(id)
-	return nil
+	return m.Store.Delete(id)
 }
 
 // toV1 maps a stored v2 building block back to the v1 representation, reconstructing the
@@ -1,8 +1,12 @@
 package clientmock
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"maps"
+	"net/http"
 	"reflect"
 	"slices"
 	"strings"
@@ -14,6 +18,7 @@ import (
 )
 
 type Client struct {
+	AccessToken                    MeshAccessTokenClient
 	ApiKey                         MeshApiKeyClient
 	BuildingBlock                  meshBuildingBlockClient
 	BuildingBlockRun               MeshBuildingBlockRunClient
@@ -25,6 +30,7 @@ type Client struct {
 	LandingZone                    MeshLandingZoneClient
 	Location                       MeshLocationClient
 	MeshInfo                       MeshInfoClient
+	MeshObject                     MeshObjectClient
 	PaymentMethod                  MeshPaymentMethodClient
 	Platform                       MeshPlatformClient
 	PlatformType                   MeshPlatformTypeClient
@@ -41,6 +47,7 @@ type Client struct {
 
 func (c *Client) AsClient() client.Client {
 	return client.Client{
+		AccessToken:                    c.AccessToken,
 		ApiKey:                         c.ApiKey,
 		BuildingBlock:                  c.BuildingBlock,
 		BuildingBlockRun:               c.BuildingBlockRun,
@@ -52,6 +59,7 @@ func (c *Client) AsClient() client.Client {
 		LandingZone:                    c.LandingZone,
 		Location:                       c.Location,
 		MeshInfo:                       c.MeshInfo,
+		MeshObject:                     c.MeshObject,
 		PaymentMethod:                  c.PaymentMethod,
 		Platform:                       c.Platform,
 		PlatformType:                   c.PlatformType,
@@ -80,8 +88,11 @@ func NewMock() Client {
 	tenantStore := NewStore[client.MeshTenant]()
 	// Shared with the tenant client so a tenant create can resolve its landing zone's default quotas.
 	landingZoneStore := NewStore[client.MeshLandingZone]()
+	// Shared with the access token client, which logs in with the API keys created by the API key client.
+	apiKeyStore := NewStore[client.MeshApiKey]()
 	return Client{
-		ApiKey:                         MeshApiKeyClient{Store: NewStore[client.MeshApiKey]()},
+		AccessToken:                    MeshAccessTokenClient{ApiKeyStore: apiKeyStore},
+		ApiKey:                         MeshApiKeyClient{Store: apiKeyStore},
 		BuildingBlock:                  meshBuildingBlockClient{Store: buildingBlockStore, BbdVersionStore: bbdVersionStore, TenantStore: tenantStore},
 		BuildingBlockRun:               MeshBuildingBlockRunClient{Store: buildingBlockRunStore, LogStore: buildingBlockRunLogStore},
 		BuildingBlockDefinition:        meshBuildingBlockDefinitionClient{Store: NewStore[client.MeshBuildingBlockDefinition](), StoreVersion: bbdVersionStore},
@@ -92,6 +103,7 @@ func NewMock() Client {
 		LandingZone:                    MeshLandingZoneClient{Store: landingZoneStore},
 		Location:                       MeshLocationClient{Store: NewStore[client.MeshLocation]()},
 		MeshInfo:                       MeshInfoClient{},
+		MeshObject:                     MeshObjectClient{Store: NewStore[map[string]any]()},
 		PaymentMethod:                  MeshPaymentMethodClient{Store: NewStore[client.MeshPaymentMethod]()},
 		Platform:                       MeshPlatformClient{Store: NewStore[client.MeshPlatform]()},
 		PlatformType:                   MeshPlatformTypeClient{Store: NewStore[client.MeshPlatformType]()},
@@ -107,11 +119,53 @@ func NewMock() Client {
 	}
 }
 
+// listAsSeq adapts the result of a mock List to the streaming All of the client interfaces.
+func listAsSeq[M any](items []M, err error) iter.Seq2[M, error] {
+	return func(yield func(M, error) bool) {
+		if err != nil {
+			var zero M
+			yield(zero, err)
+			return
+		}
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// meshObjectETag mimics the backend's ETag, which changes with every change of the meshObject.
+func meshObjectETag(meshObject any) (string, error) {
+	data, err := json.Marshal(meshObject)
+	if err != nil {
+		return "", fmt.Errorf("cannot compute ETag of %T: %w", meshObject, err)
+	}
+	return fmt.Sprintf(`"%x"`, sha256.Sum256(data)), nil
+}
+
+// checkIfMatch fails with a 412 Precondition Failed like the backend if the existing meshObject does not
+// have the given ETag. An empty ETag or a missing meshObject is not checked.
+func checkIfMatch[M any](existing *M, etag string) error {
+	if etag == "" || existing == nil {
+		return nil
+	}
+	if existingETag, err := meshObjectETag(existing); err != nil {
+		return err
+	} else if existingETag != etag {
+		return client.HttpError{StatusCode: http.StatusPreconditionFailed}
+	}
+	return nil
+}
+
 // Store is a concurrency-safe key-value store for mock client data.
 // Always use NewStore to create instances; pass *Store to mock client structs.
+// Changes to a stored value must be written back with Set, so that the offline mode persists them.
 type Store[M any] struct {
 	mu   sync.RWMutex
 	data map[string]*M
+	// onChange is called after every Set and Delete, which fail with its error, see NewOffline.
+	onChange func() error
 }
 
 func NewStore[M any]() *Store[M] {
@@ -125,16 +179,28 @@ func (s *Store[M]) Get(key string) (*M, bool) {
 	return v, ok
 }
 
-func (s *Store[M]) Set(key string, val *M) {
+// Set stores val under key. It fails only if the offline mode cannot persist the change, see NewOffline.
+func (s *Store[M]) Set(key string, val *M) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
 	s.data[key] = val
+	onChange := s.onChange
+	s.mu.Unlock()
+	if onChange != nil {
+		return onChange()
+	}
+	return nil
 }
 
-func (s *Store[M]) Delete(key string) {
+// Delete removes key. It fails only if the offline mode cannot persist the change, see NewOffline.
+func (s *Store[M]) Delete(key string) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
 	delete(s.data, key)
+	onChange := s.onChange
+	s.mu.Unlock()
+	if onChange != nil {
+		return onChange()
+	}
+	return nil
 }
 
 // Values returns a snapshot of all stored values.
@@ -154,6 +220,23 @@ func (s *Store[M]) SortedKeys() []string {
 	return slices.SortedFunc(maps.Keys(s.data), strings.Compare)
 }
 
+func (s *Store[M]) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return json.Marshal(s.data)
+}
+
+func (s *Store[M]) UnmarshalJSON(data []byte) error {
+	values := make(map[string]*M)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = values
+	return nil
+}
+
 // backendSecretBehavior mocks backend behavior in the sense that it consumes the plaintext secret and returns a hash of the secret only.
 func backendSecretBehavior[T any](allowSecretHashOnlyOnCreate bool, dto, existingDto *T) {
 	handleSecret := func(secret, existingSecret *clientTypes.Secret) {
@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/google/uuid"
 
@@ -29,7 +30,9 @@ func (m MeshIntegrationClient) Create(_ context.Context, integration client.Mesh
 	if created.Spec.Config.EntraId != nil && created.Spec.Config.EntraId.RedirectUrl == nil {
 		created.Spec.Config.EntraId.RedirectUrl = new(fmt.Sprintf("https://meshstack.example.com/oauth/redirect/%s", integrationUuid))
 	}
-	m.Store.Set(integrationUuid, created)
+	if err := m.Store.Set(integrationUuid, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -48,14 +51,16 @@ func (m MeshIntegrationClient) Update(_ context.Context, integration client.Mesh
 			integration.Spec.Config.EntraId.RedirectUrl = existing.Spec.Config.EntraId.RedirectUrl
 		}
 		existing.Spec = integration.Spec
+		if err := m.Store.Set(*integration.Metadata.Uuid, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("integration not found: %s", *integration.Metadata.Uuid)
 }
 
 func (m MeshIntegrationClient) Delete(_ context.Context, uuid string) error {
-	m.Store.Delete(uuid)
-	return nil
+	return m.Store.Delete(uuid)
 }
 
 func (m MeshIntegrationClient) List(_ context.Context) ([]client.MeshIntegration, error) {
@@ -65,3 +70,7 @@ func (m MeshIntegrationClient) List(_ context.Context) ([]client.MeshIntegration
 	}
 	return result, nil
 }
+
+func (m MeshIntegrationClient) All(ctx context.Context) iter.Seq2[client.MeshIntegration, error] {
+	return listAsSeq(m.List(ctx))
+}
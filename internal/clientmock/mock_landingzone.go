@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
 )
@@ -42,6 +43,10 @@ func (m MeshLandingZoneClient) List(_ context.Context, query client.MeshLandingZ
 	return result, nil
 }
 
+func (m MeshLandingZoneClient) All(ctx context.Context, query client.MeshLandingZoneListQuery) iter.Seq2[client.MeshLandingZone, error] {
+	return listAsSeq(m.List(ctx, query))
+}
+
 func (m MeshLandingZoneClient) Create(_ context.Context, landingZone *client.MeshLandingZoneCreate) (*client.MeshLandingZone, error) {
 	created := &client.MeshLandingZone{
 		Metadata: landingZone.Metadata,
@@ -51,7 +56,9 @@ func (m MeshLandingZoneClient) Create(_ context.Context, landingZone *client.Mes
 			Restricted: landingZone.Spec.Restricted,
 		},
 	}
-	m.Store.Set(landingZone.Metadata.Name, created)
+	if err := m.Store.Set(landingZone.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -64,10 +71,34 @@ func (m MeshLandingZoneClient) Update(_ context.Context, name string, landingZon
 	existing.Metadata = landingZone.Metadata
 	existing.Spec = landingZone.Spec
 	existing.Status.Restricted = landingZone.Spec.Restricted
+	if err := m.Store.Set(name, existing); err != nil {
+		return nil, err
+	}
 	return existing, nil
 }
 
+func (m MeshLandingZoneClient) ReadWithETag(ctx context.Context, name string) (*client.MeshLandingZone, string, error) {
+	landingZone, err := m.Read(ctx, name)
+	if landingZone == nil || err != nil {
+		return landingZone, "", err
+	}
+	etag, err := meshObjectETag(landingZone)
+	return landingZone, etag, err
+}
+
+func (m MeshLandingZoneClient) UpdateIfMatch(ctx context.Context, name, etag string, landingZone *client.MeshLandingZoneCreate) (*client.MeshLandingZone, string, error) {
+	existing, _ := m.Store.Get(name)
+	if err := checkIfMatch(existing, etag); err != nil {
+		return nil, "", err
+	}
+	updated, err := m.Update(ctx, name, landingZone)
+	if err != nil {
+		return nil, "", err
+	}
+	newETag, err := meshObjectETag(updated)
+	return updated, newETag, err
+}
+
 func (m MeshLandingZoneClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
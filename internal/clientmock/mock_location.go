@@ -33,19 +33,23 @@ func (m MeshLocationClient) Create(_ context.Context, location *client.MeshLocat
 			IsPublic: false,
 		},
 	}
-	m.Store.Set(created.Metadata.Name, created)
+	if err := m.Store.Set(created.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
 func (m MeshLocationClient) Update(_ context.Context, name string, location *client.MeshLocationCreate) (*client.MeshLocation, error) {
 	if existing, ok := m.Store.Get(name); ok {
 		existing.Spec = location.Spec
+		if err := m.Store.Set(name, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("location not found: %s", name)
 }
 
 func (m MeshLocationClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
@@ -12,8 +12,9 @@ func (m MeshInfoClient) Read(_ context.Context) (*client.MeshInfo, error) {
 	return &client.MeshInfo{
 		// The mock client factory (see ApplyAndTest) never sees the provider's actual configured
 		// endpoint, since it bypasses newProviderClient entirely.
-		Endpoint:            "http://localhost:8080",
-		Version:             "2026.30.0",
+		Endpoint: "http://localhost:8080",
+		// The oldest meshStack this provider supports, see featureGates for attributes requiring a newer one.
+		Version:             client.MinMeshStackVersion.String(),
 		EnabledFeatureFlags: []string{client.FeatureFlagFourEyesRoleApproval},
 		Metadata: map[string]string{
 			"test": "test",
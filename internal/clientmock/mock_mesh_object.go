@@ -0,0 +1,118 @@
+package clientmock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// MeshObjectClient keeps the meshObjects managed as plain JSON in a store of their own, keyed by kind and
+// metadata.uuid. Unlike on the real backend, they are not visible to the typed mock clients of their kind.
+type MeshObjectClient struct {
+	Store *Store[map[string]any]
+}
+
+func (m MeshObjectClient) Read(_ context.Context, kind, _, identifier string) (json.RawMessage, error) {
+	_, object := m.find(kind, identifier)
+	if object == nil {
+		return nil, nil
+	}
+	return json.Marshal(object)
+}
+
+func (m MeshObjectClient) Create(_ context.Context, kind, apiVersion string, payload json.RawMessage) (json.RawMessage, error) {
+	var object map[string]any
+	if err := json.Unmarshal(payload, &object); err != nil {
+		return nil, err
+	}
+	metadata, _ := object["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	if name, ok := metadata["name"].(string); ok {
+		if key, _ := m.find(kind, name); key != "" {
+			return nil, client.HttpError{StatusCode: http.StatusConflict, Message: fmt.Sprintf("%s %s already exists", kind, name)}
+		}
+	}
+	metadata["uuid"] = uuid.NewString()
+	object["metadata"] = metadata
+	object["apiVersion"], object["kind"] = apiVersion, kind
+	object["status"] = map[string]any{}
+	if err := m.Store.Set(kind+"/"+metadata["uuid"].(string), &object); err != nil { //nolint:forcetypeassert // set above
+		return nil, err
+	}
+	return json.Marshal(object)
+}
+
+func (m MeshObjectClient) Update(_ context.Context, kind, apiVersion, identifier string, payload json.RawMessage) (json.RawMessage, error) {
+	key, existing := m.find(kind, identifier)
+	if existing == nil {
+		return nil, fmt.Errorf("%s not found: %s", kind, identifier)
+	}
+	var object map[string]any
+	if err := json.Unmarshal(payload, &object); err != nil {
+		return nil, err
+	}
+	// The backend owns metadata.uuid and the status.
+	metadata, _ := object["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	metadata["uuid"] = existing["metadata"].(map[string]any)["uuid"] //nolint:forcetypeassert // set by Create
+	object["metadata"] = metadata
+	object["apiVersion"], object["kind"] = apiVersion, kind
+	object["status"] = existing["status"]
+	if err := m.Store.Set(key, &object); err != nil {
+		return nil, err
+	}
+	return json.Marshal(object)
+}
+
+func (m MeshObjectClient) Delete(_ context.Context, kind, _, identifier string) error {
+	if key, _ := m.find(kind, identifier); key != "" {
+		if err := m.Store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List ignores the query, which depends on the kind.
+func (m MeshObjectClient) List(_ context.Context, kind, _ string, _ map[string]string) ([]json.RawMessage, error) {
+	items := []json.RawMessage{}
+	for _, key := range m.Store.SortedKeys() {
+		if object, ok := m.Store.Get(key); ok && (*object)["kind"] == kind {
+			item, err := json.Marshal(*object)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (m MeshObjectClient) All(ctx context.Context, kind, apiVersion string, query map[string]string) iter.Seq2[json.RawMessage, error] {
+	return listAsSeq(m.List(ctx, kind, apiVersion, query))
+}
+
+// find looks up a meshObject of kind by its metadata.uuid or metadata.name.
+func (m MeshObjectClient) find(kind, identifier string) (key string, object map[string]any) {
+	for _, key := range m.Store.SortedKeys() {
+		object, ok := m.Store.Get(key)
+		if !ok || (*object)["kind"] != kind {
+			continue
+		}
+		metadata, _ := (*object)["metadata"].(map[string]any)
+		if metadata["uuid"] == identifier || metadata["name"] == identifier {
+			return key, *object
+		}
+	}
+	return "", nil
+}
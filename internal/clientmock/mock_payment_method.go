@@ -31,7 +31,9 @@ func (m MeshPaymentMethodClient) Create(_ context.Context, paymentMethod *client
 		created.Spec.Tags = map[string][]string{}
 	}
 
-	m.Store.Set(paymentMethod.Metadata.Name, created)
+	if err := m.Store.Set(paymentMethod.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -45,11 +47,13 @@ func (m MeshPaymentMethodClient) Update(_ context.Context, identifier string, pa
 	if existing.Spec.Tags == nil {
 		existing.Spec.Tags = map[string][]string{}
 	}
+	if err := m.Store.Set(identifier, existing); err != nil {
+		return nil, err
+	}
 
 	return existing, nil
 }
 
 func (m MeshPaymentMethodClient) Delete(_ context.Context, identifier string) error {
-	m.Store.Delete(identifier)
-	return nil
+	return m.Store.Delete(identifier)
 }
@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 	"slices"
 
 	"github.com/google/uuid"
@@ -55,11 +56,17 @@ func (m MeshPlatformClient) List(_ context.Context, query client.MeshPlatformLis
 	return result, nil
 }
 
+func (m MeshPlatformClient) All(ctx context.Context, query client.MeshPlatformListQuery) iter.Seq2[client.MeshPlatform, error] {
+	return listAsSeq(m.List(ctx, query))
+}
+
 func (m MeshPlatformClient) Create(_ context.Context, platform client.MeshPlatform) (*client.MeshPlatform, error) {
 	platformUuid := uuid.NewString()
 	platform.Metadata.Uuid = &platformUuid
 	backendSecretBehavior(true, &platform, nil)
-	m.Store.Set(platformUuid, &platform)
+	if err := m.Store.Set(platformUuid, &platform); err != nil {
+		return nil, err
+	}
 	return &platform, nil
 }
 
@@ -67,12 +74,14 @@ func (m MeshPlatformClient) Update(_ context.Context, uuid string, platform clie
 	if existing, ok := m.Store.Get(uuid); ok {
 		backendSecretBehavior(false, &platform.Spec, &existing.Spec)
 		existing.Spec = platform.Spec
+		if err := m.Store.Set(uuid, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("platform not found: %s", uuid)
 }
 
 func (m MeshPlatformClient) Delete(_ context.Context, uuid string) error {
-	m.Store.Delete(uuid)
-	return nil
+	return m.Store.Delete(uuid)
 }
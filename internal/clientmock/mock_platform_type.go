@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/google/uuid"
 
@@ -35,21 +36,25 @@ func (m MeshPlatformTypeClient) Create(_ context.Context, platformType *client.M
 			},
 		},
 	}
-	m.Store.Set(created.Metadata.Name, created)
+	if err := m.Store.Set(created.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
 func (m MeshPlatformTypeClient) Update(_ context.Context, name string, platformType *client.MeshPlatformTypeCreate) (*client.MeshPlatformType, error) {
 	if existing, ok := m.Store.Get(name); ok {
 		existing.Spec = platformType.Spec
+		if err := m.Store.Set(name, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("platform type not found: %s", name)
 }
 
 func (m MeshPlatformTypeClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
 
 func (m MeshPlatformTypeClient) List(_ context.Context, category *string, lifecycleStatus *string) ([]client.MeshPlatformType, error) {
@@ -65,3 +70,7 @@ func (m MeshPlatformTypeClient) List(_ context.Context, category *string, lifecy
 	}
 	return result, nil
 }
+
+func (m MeshPlatformTypeClient) All(ctx context.Context, category *string, lifecycleStatus *string) iter.Seq2[client.MeshPlatformType, error] {
+	return listAsSeq(m.List(ctx, category, lifecycleStatus))
+}
@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
@@ -27,6 +28,10 @@ func (m MeshProjectClient) List(_ context.Context, workspaceIdentifier string, p
 	return result, nil
 }
 
+func (m MeshProjectClient) All(ctx context.Context, workspaceIdentifier string, paymentMethodIdentifier *string) iter.Seq2[client.MeshProject, error] {
+	return listAsSeq(m.List(ctx, workspaceIdentifier, paymentMethodIdentifier))
+}
+
 func (m MeshProjectClient) Create(_ context.Context, project *client.MeshProjectCreate) (*client.MeshProject, error) {
 	created := &client.MeshProject{
 		Metadata: client.MeshProjectMetadata{
@@ -36,7 +41,9 @@ func (m MeshProjectClient) Create(_ context.Context, project *client.MeshProject
 		},
 		Spec: project.Spec,
 	}
-	m.Store.Set(project.Metadata.OwnedByWorkspace+"."+project.Metadata.Name, created)
+	if err := m.Store.Set(project.Metadata.OwnedByWorkspace+"."+project.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -47,10 +54,12 @@ func (m MeshProjectClient) Update(_ context.Context, project *client.MeshProject
 		return nil, fmt.Errorf("project not found: %s", key)
 	}
 	existing.Spec = project.Spec
+	if err := m.Store.Set(key, existing); err != nil {
+		return nil, err
+	}
 	return existing, nil
 }
 
 func (m MeshProjectClient) Delete(_ context.Context, workspace string, name string) error {
-	m.Store.Delete(workspace + "." + name)
-	return nil
+	return m.Store.Delete(workspace + "." + name)
 }
@@ -16,11 +16,12 @@ func (m MeshProjectGroupBindingClient) Read(_ context.Context, name string) (*cl
 }
 
 func (m MeshProjectGroupBindingClient) Create(_ context.Context, binding *client.MeshProjectGroupBinding) (*client.MeshProjectGroupBinding, error) {
-	m.Store.Set(binding.Metadata.Name, binding)
+	if err := m.Store.Set(binding.Metadata.Name, binding); err != nil {
+		return nil, err
+	}
 	return binding, nil
 }
 
 func (m MeshProjectGroupBindingClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
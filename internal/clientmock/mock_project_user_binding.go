@@ -16,11 +16,12 @@ func (m MeshProjectUserBindingClient) Read(_ context.Context, name string) (*cli
 }
 
 func (m MeshProjectUserBindingClient) Create(_ context.Context, binding *client.MeshProjectUserBinding) (*client.MeshProjectUserBinding, error) {
-	m.Store.Set(binding.Metadata.Name, binding)
+	if err := m.Store.Set(binding.Metadata.Name, binding); err != nil {
+		return nil, err
+	}
 	return binding, nil
 }
 
 func (m MeshProjectUserBindingClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
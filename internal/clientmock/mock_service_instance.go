@@ -2,6 +2,7 @@ package clientmock
 
 import (
 	"context"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
 )
@@ -39,3 +40,7 @@ func (m MeshServiceInstanceClient) List(_ context.Context, filter client.MeshSer
 	}
 	return result, nil
 }
+
+func (m MeshServiceInstanceClient) All(ctx context.Context, filter client.MeshServiceInstanceFilter) iter.Seq2[client.MeshServiceInstance, error] {
+	return listAsSeq(m.List(ctx, filter))
+}
@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
 )
@@ -19,6 +20,10 @@ func (m MeshTagDefinitionClient) List(_ context.Context) ([]client.MeshTagDefini
 	return result, nil
 }
 
+func (m MeshTagDefinitionClient) All(ctx context.Context) iter.Seq2[client.MeshTagDefinition, error] {
+	return listAsSeq(m.List(ctx))
+}
+
 func (m MeshTagDefinitionClient) Read(_ context.Context, name string) (*client.MeshTagDefinition, error) {
 	if def, ok := m.Store.Get(name); ok {
 		return def, nil
@@ -31,7 +36,9 @@ func (m MeshTagDefinitionClient) Create(_ context.Context, tagDefinition *client
 		Metadata: tagDefinition.Metadata,
 		Spec:     tagDefinition.Spec,
 	}
-	m.Store.Set(created.Metadata.Name, created)
+	if err := m.Store.Set(created.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -39,12 +46,14 @@ func (m MeshTagDefinitionClient) Update(_ context.Context, tagDefinition *client
 	name := tagDefinition.Metadata.Name
 	if existing, ok := m.Store.Get(name); ok {
 		existing.Spec = tagDefinition.Spec
+		if err := m.Store.Set(name, existing); err != nil {
+			return nil, err
+		}
 		return existing, nil
 	}
 	return nil, fmt.Errorf("tag definition not found: %s", name)
 }
 
 func (m MeshTagDefinitionClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
@@ -2,6 +2,7 @@ package clientmock
 
 import (
 	"context"
+	"iter"
 	"time"
 
 	"github.com/google/uuid"
@@ -25,7 +26,9 @@ func (m MeshTenantClient) Read(_ context.Context, uuid string) (*client.MeshTena
 	if t.Status.Lifecycle.State == client.TenantLifecycleStateMarkedForDeletion {
 		deleted := *t
 		deleted.Status.Lifecycle.State = client.TenantLifecycleStateDeleted
-		m.Store.Set(uuid, &deleted)
+		if err := m.Store.Set(uuid, &deleted); err != nil {
+			return nil, err
+		}
 	}
 	return t, nil
 }
@@ -52,6 +55,7 @@ func (m MeshTenantClient) Create(_ context.Context, tenant *client.MeshTenantCre
 			Uuid:             id,
 			OwnedByProject:   tenant.Metadata.OwnedByProject,
 			OwnedByWorkspace: tenant.Metadata.OwnedByWorkspace,
+			CreatedOn:        time.Now().UTC().Format(time.RFC3339),
 		},
 		Spec: client.MeshTenantSpec{
 			PlatformRef:      tenant.Spec.PlatformRef,
@@ -69,7 +73,9 @@ func (m MeshTenantClient) Create(_ context.Context, tenant *client.MeshTenantCre
 		},
 	}
 
-	m.Store.Set(id, created)
+	if err := m.Store.Set(id, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -83,8 +89,7 @@ func (m MeshTenantClient) Delete(_ context.Context, uuid string) error {
 		State:             client.TenantLifecycleStateMarkedForDeletion,
 		MarkedForDeletion: &client.MeshTenantLifecycleAction{Timestamp: time.Now().UTC().Format(time.RFC3339)},
 	}
-	m.Store.Set(uuid, &marked)
-	return nil
+	return m.Store.Set(uuid, &marked)
 }
 
 // landingZoneDefaultQuotas returns the default quotas of the landing zone a tenant is assigned to, or
@@ -153,3 +158,7 @@ func (m MeshTenantClient) List(_ context.Context, query client.MeshTenantQuery)
 	}
 	return result, nil
 }
+
+func (m MeshTenantClient) All(ctx context.Context, query client.MeshTenantQuery) iter.Seq2[client.MeshTenant, error] {
+	return listAsSeq(m.List(ctx, query))
+}
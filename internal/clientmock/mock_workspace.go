@@ -3,6 +3,7 @@ package clientmock
 import (
 	"context"
 	"fmt"
+	"iter"
 	"slices"
 	"time"
 
@@ -38,6 +39,20 @@ func (m MeshWorkspaceClient) Read(_ context.Context, name string) (*client.MeshW
 	return &cp, nil
 }
 
+func (m MeshWorkspaceClient) List(ctx context.Context) ([]client.MeshWorkspace, error) {
+	workspaces := []client.MeshWorkspace{}
+	for _, name := range m.Store.SortedKeys() {
+		if workspace, _ := m.Read(ctx, name); workspace != nil {
+			workspaces = append(workspaces, *workspace)
+		}
+	}
+	return workspaces, nil
+}
+
+func (m MeshWorkspaceClient) All(ctx context.Context) iter.Seq2[client.MeshWorkspace, error] {
+	return listAsSeq(m.List(ctx))
+}
+
 func (m MeshWorkspaceClient) Create(_ context.Context, workspace *client.MeshWorkspaceCreate) (*client.MeshWorkspace, error) {
 	tagsCopy := copyTags(workspace.Metadata.Tags)
 	created := &client.MeshWorkspace{
@@ -49,7 +64,9 @@ func (m MeshWorkspaceClient) Create(_ context.Context, workspace *client.MeshWor
 		Spec: workspace.Spec,
 	}
 
-	m.Store.Set(workspace.Metadata.Name, created)
+	if err := m.Store.Set(workspace.Metadata.Name, created); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -69,11 +86,34 @@ func (m MeshWorkspaceClient) Update(_ context.Context, name string, workspace *c
 		},
 		Spec: workspace.Spec,
 	}
-	m.Store.Set(name, updated)
+	if err := m.Store.Set(name, updated); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
+func (m MeshWorkspaceClient) ReadWithETag(ctx context.Context, name string) (*client.MeshWorkspace, string, error) {
+	workspace, err := m.Read(ctx, name)
+	if workspace == nil || err != nil {
+		return workspace, "", err
+	}
+	etag, err := meshObjectETag(workspace)
+	return workspace, etag, err
+}
+
+func (m MeshWorkspaceClient) UpdateIfMatch(ctx context.Context, name, etag string, workspace *client.MeshWorkspaceCreate) (*client.MeshWorkspace, string, error) {
+	existing, _ := m.Store.Get(name)
+	if err := checkIfMatch(existing, etag); err != nil {
+		return nil, "", err
+	}
+	updated, err := m.Update(ctx, name, workspace)
+	if err != nil {
+		return nil, "", err
+	}
+	newETag, err := meshObjectETag(updated)
+	return updated, newETag, err
+}
+
 func (m MeshWorkspaceClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
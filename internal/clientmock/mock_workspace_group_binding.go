@@ -16,11 +16,12 @@ func (m MeshWorkspaceGroupBindingClient) Read(_ context.Context, name string) (*
 }
 
 func (m MeshWorkspaceGroupBindingClient) Create(_ context.Context, binding *client.MeshWorkspaceGroupBinding) (*client.MeshWorkspaceGroupBinding, error) {
-	m.Store.Set(binding.Metadata.Name, binding)
+	if err := m.Store.Set(binding.Metadata.Name, binding); err != nil {
+		return nil, err
+	}
 	return binding, nil
 }
 
 func (m MeshWorkspaceGroupBindingClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
@@ -16,11 +16,12 @@ func (m MeshWorkspaceUserBindingClient) Read(_ context.Context, name string) (*c
 }
 
 func (m MeshWorkspaceUserBindingClient) Create(_ context.Context, binding *client.MeshWorkspaceUserBinding) (*client.MeshWorkspaceUserBinding, error) {
-	m.Store.Set(binding.Metadata.Name, binding)
+	if err := m.Store.Set(binding.Metadata.Name, binding); err != nil {
+		return nil, err
+	}
 	return binding, nil
 }
 
 func (m MeshWorkspaceUserBindingClient) Delete(_ context.Context, name string) error {
-	m.Store.Delete(name)
-	return nil
+	return m.Store.Delete(name)
 }
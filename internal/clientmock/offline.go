@@ -0,0 +1,193 @@
+package clientmock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// Seed is the data a new offline mock starts with, e.g. the workspaces, platforms and building block
+// definitions of a workshop. The objects have the JSON format of the meshObject API and are created as
+// with the API, so meshStack-assigned fields such as metadata.uuid are set by the mock.
+type Seed struct {
+	Workspaces               []client.MeshWorkspaceCreate         `json:"workspaces"`
+	Platforms                []client.MeshPlatform                `json:"platforms"`
+	BuildingBlockDefinitions []client.MeshBuildingBlockDefinition `json:"buildingBlockDefinitions"`
+}
+
+func ReadSeed(seedFile string) (seed Seed, err error) {
+	data, err := os.ReadFile(seedFile)
+	if err != nil {
+		return seed, err
+	}
+	if err := json.Unmarshal(data, &seed); err != nil {
+		return seed, fmt.Errorf("invalid seed file %s: %w", seedFile, err)
+	}
+	return seed, nil
+}
+
+var (
+	offlineMocksMu sync.Mutex
+	// offlineMocks are the mocks of NewOffline by the absolute path of their state file.
+	offlineMocks = map[string]Client{}
+)
+
+// NewOffline returns a mock which keeps its stores in stateFile, so that they survive between Terraform
+// runs: the stores are loaded from stateFile if it exists, and written to it after every change.
+// A new stateFile starts with the given seed.
+//
+// All calls for the same stateFile return the same mock, e.g. for several provider configurations of a run,
+// since each mock would overwrite stateFile with its own stores and drop the changes of the others.
+func NewOffline(ctx context.Context, stateFile string, seed Seed) (Client, error) {
+	absStateFile, err := filepath.Abs(stateFile)
+	if err != nil {
+		return Client{}, err
+	}
+	offlineMocksMu.Lock()
+	defer offlineMocksMu.Unlock()
+	if mock, ok := offlineMocks[absStateFile]; ok {
+		return mock, nil
+	}
+	mock, err := newOffline(ctx, absStateFile, seed)
+	if err == nil {
+		offlineMocks[absStateFile] = mock
+	}
+	return mock, err
+}
+
+// newOffline loads the mock of NewOffline from stateFile.
+func newOffline(ctx context.Context, stateFile string, seed Seed) (Client, error) {
+	mock := NewMock()
+	stores := mock.persistentStores()
+	data, err := os.ReadFile(stateFile)
+	switch {
+	case err == nil:
+		var state map[string]json.RawMessage
+		if err := json.Unmarshal(data, &state); err != nil {
+			return mock, fmt.Errorf("invalid state file %s: %w", stateFile, err)
+		}
+		for name, storeData := range state {
+			if store, ok := stores[name]; ok {
+				if err := store.UnmarshalJSON(storeData); err != nil {
+					return mock, fmt.Errorf("invalid %s in state file %s: %w", name, stateFile, err)
+				}
+			}
+		}
+	case errors.Is(err, fs.ErrNotExist):
+		if err := mock.applySeed(ctx, seed); err != nil {
+			return mock, err
+		}
+	default:
+		return mock, err
+	}
+
+	var mu sync.Mutex
+	save := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		return writeState(stateFile, stores)
+	}
+	if err := save(); err != nil {
+		return mock, err
+	}
+	for _, store := range stores {
+		// The change stays in memory, but the mock client call making it fails, so that Terraform reports
+		// that the state file is behind instead of silently losing the change with the next run.
+		store.setOnChange(func() error {
+			if err := save(); err != nil {
+				return fmt.Errorf("cannot write offline state file %s: %w", stateFile, err)
+			}
+			return nil
+		})
+	}
+	return mock, nil
+}
+
+type persistentStore interface {
+	json.Marshaler
+	json.Unmarshaler
+	setOnChange(onChange func() error)
+}
+
+func (s *Store[M]) setOnChange(onChange func() error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onChange = onChange
+}
+
+// persistentStores returns the stores of the mock by their name in the state file. Stores shared by several
+// clients are listed once.
+func (c *Client) persistentStores() map[string]persistentStore {
+	return map[string]persistentStore{
+		"apiKeys":                         c.ApiKey.Store,
+		"buildingBlocks":                  c.BuildingBlockV2.Store,
+		"buildingBlockRuns":               c.BuildingBlockRun.Store,
+		"buildingBlockRunLogs":            c.BuildingBlockRun.LogStore,
+		"buildingBlockDefinitions":        c.BuildingBlockDefinition.Store,
+		"buildingBlockDefinitionVersions": c.BuildingBlockDefinitionVersion.Store,
+		"buildingBlockRunners":            c.BuildingBlockRunner.Store,
+		"integrations":                    c.Integration.Store,
+		"landingZones":                    c.LandingZone.Store,
+		"locations":                       c.Location.Store,
+		"meshObjects":                     c.MeshObject.Store,
+		"paymentMethods":                  c.PaymentMethod.Store,
+		"platforms":                       c.Platform.Store,
+		"platformTypes":                   c.PlatformType.Store,
+		"projects":                        c.Project.Store,
+		"projectGroupBindings":            c.ProjectGroupBinding.Store,
+		"projectUserBindings":             c.ProjectUserBinding.Store,
+		"serviceInstances":                c.ServiceInstance.Store,
+		"tagDefinitions":                  c.TagDefinition.Store,
+		"tenants":                         c.Tenant.Store,
+		"workspaces":                      c.Workspace.Store,
+		"workspaceGroupBindings":          c.WorkspaceGroupBinding.Store,
+		"workspaceUserBindings":           c.WorkspaceUserBinding.Store,
+	}
+}
+
+func (c *Client) applySeed(ctx context.Context, seed Seed) error {
+	for _, workspace := range seed.Workspaces {
+		if _, err := c.Workspace.Create(ctx, &workspace); err != nil {
+			return fmt.Errorf("cannot seed workspace %s: %w", workspace.Metadata.Name, err)
+		}
+	}
+	for _, platform := range seed.Platforms {
+		if _, err := c.Platform.Create(ctx, platform); err != nil {
+			return fmt.Errorf("cannot seed platform %s: %w", platform.Metadata.Name, err)
+		}
+	}
+	for _, definition := range seed.BuildingBlockDefinitions {
+		if _, err := c.BuildingBlockDefinition.Create(ctx, definition); err != nil {
+			return fmt.Errorf("cannot seed building block definition %s: %w", definition.Spec.DisplayName, err)
+		}
+	}
+	return nil
+}
+
+// writeState replaces stateFile in one step, so that an interrupted run does not leave a truncated file behind.
+func writeState(stateFile string, stores map[string]persistentStore) error {
+	data, err := json.MarshalIndent(stores, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(stateFile), filepath.Base(stateFile)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), stateFile)
+}
@@ -0,0 +1,113 @@
+package clientmock
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+func TestNewOffline(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	seed := Seed{
+		Workspaces: []client.MeshWorkspaceCreate{{
+			Metadata: client.MeshWorkspaceCreateMetadata{Name: "workshop"},
+			Spec:     client.MeshWorkspaceSpec{DisplayName: "Workshop"},
+		}},
+		Platforms: []client.MeshPlatform{{
+			Metadata: client.MeshPlatformMetadata{Name: "cloud", OwnedByWorkspace: "workshop"},
+			Spec:     client.MeshPlatformSpec{DisplayName: "Cloud"},
+		}},
+	}
+
+	mock, err := NewOffline(t.Context(), stateFile, seed)
+	require.NoError(t, err)
+	workspace, err := mock.Workspace.Read(t.Context(), "workshop")
+	require.NoError(t, err)
+	require.NotNil(t, workspace)
+	platforms, err := mock.Platform.List(t.Context(), client.MeshPlatformListQuery{})
+	require.NoError(t, err)
+	require.Len(t, platforms, 1)
+
+	_, err = mock.Project.Create(t.Context(), &client.MeshProjectCreate{
+		Metadata: client.MeshProjectCreateMetadata{Name: "app", OwnedByWorkspace: "workshop"},
+		Spec:     client.MeshProjectSpec{DisplayName: "App"},
+	})
+	require.NoError(t, err)
+	_, err = mock.Workspace.Update(t.Context(), "workshop", &client.MeshWorkspaceCreate{
+		Metadata: client.MeshWorkspaceCreateMetadata{Name: "workshop"},
+		Spec:     client.MeshWorkspaceSpec{DisplayName: "Updated Workshop"},
+	})
+	require.NoError(t, err)
+
+	// The seed only applies to a new state file.
+	reloaded, err := newOffline(t.Context(), stateFile, Seed{})
+	require.NoError(t, err)
+	workspace, err = reloaded.Workspace.Read(t.Context(), "workshop")
+	require.NoError(t, err)
+	require.NotNil(t, workspace)
+	assert.Equal(t, "Updated Workshop", workspace.Spec.DisplayName)
+	project, err := reloaded.Project.Read(t.Context(), "workshop", "app")
+	require.NoError(t, err)
+	require.NotNil(t, project)
+	assert.Equal(t, "App", project.Spec.DisplayName)
+	reloadedPlatforms, err := reloaded.Platform.List(t.Context(), client.MeshPlatformListQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, platforms, reloadedPlatforms)
+
+	require.NoError(t, reloaded.Project.Delete(t.Context(), "workshop", "app"))
+	reloaded, err = newOffline(t.Context(), stateFile, Seed{})
+	require.NoError(t, err)
+	project, err = reloaded.Project.Read(t.Context(), "workshop", "app")
+	require.NoError(t, err)
+	assert.Nil(t, project)
+
+	entries, err := os.ReadDir(filepath.Dir(stateFile))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary files are left behind")
+}
+
+func TestNewOffline_SharedPerStateFile(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Chdir(stateDir)
+	first, err := NewOffline(t.Context(), "state.json", Seed{})
+	require.NoError(t, err)
+	second, err := NewOffline(t.Context(), filepath.Join(stateDir, "state.json"), Seed{})
+	require.NoError(t, err)
+
+	for name, mock := range map[string]Client{"first": first, "second": second} {
+		_, err := mock.Workspace.Create(t.Context(), &client.MeshWorkspaceCreate{
+			Metadata: client.MeshWorkspaceCreateMetadata{Name: name},
+		})
+		require.NoError(t, err)
+	}
+	workspace, err := first.Workspace.Read(t.Context(), "second")
+	require.NoError(t, err)
+	assert.NotNil(t, workspace)
+
+	// Neither mock dropped the workspace of the other when writing the state file.
+	reloaded, err := newOffline(t.Context(), filepath.Join(stateDir, "state.json"), Seed{})
+	require.NoError(t, err)
+	workspaces, err := reloaded.Workspace.List(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, workspaces, 2)
+}
+
+func TestNewOffline_FailsChangesNotWritten(t *testing.T) {
+	stateDir := filepath.Join(t.TempDir(), "state")
+	require.NoError(t, os.Mkdir(stateDir, 0o700))
+	mock, err := NewOffline(t.Context(), filepath.Join(stateDir, "state.json"), Seed{})
+	require.NoError(t, err)
+	// E.g. an unmounted or full volume; unlike a read-only directory, this also fails when run as root.
+	require.NoError(t, os.RemoveAll(stateDir))
+
+	_, err = mock.Workspace.Create(t.Context(), &client.MeshWorkspaceCreate{
+		Metadata: client.MeshWorkspaceCreateMetadata{Name: "workshop"},
+	})
+	require.ErrorContains(t, err, "cannot write offline state file")
+	require.ErrorContains(t, mock.Workspace.Delete(t.Context(), "workshop"), "cannot write offline state file")
+}
@@ -0,0 +1,728 @@
+package clientmock
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// Server is a fake meshStack serving the meshObject API from the stores of Mock, so that tests can run the
+// real client.New against it and cover the HTTP layer, pagination, MIME types and JSON (un)marshalling
+// together. Create it with NewServer and Close it when done.
+//
+// The kinds of newMeshObjectRoutes are served through their mock client, so they behave like with the mock
+// alone, including the filters of their lists. Any other kind is kept as plain JSON by MeshObjectClient, in
+// whatever API version the request accepts, and its lists are not filtered. Lists are paginated like by
+// meshStack.
+//
+// Like meshStack, meshObjects are served with an ETag, which a PUT or DELETE with If-Match must match, and
+// creating a meshObject that already exists is a 409 Conflict. A POST with an Idempotency-Key that was seen
+// before gets the response of the first one, without creating the meshObject again.
+type Server struct {
+	*httptest.Server
+	Mock Client
+	// ApiKey and ApiSecret are the client credentials /api/login accepts.
+	ApiKey    string
+	ApiSecret string
+
+	routes map[string]meshObjectRoute
+	mu     sync.Mutex
+	tokens map[string]bool
+	// idempotentResponses are the responses of the POSTs by their Idempotency-Key.
+	idempotentResponses map[string]*httptest.ResponseRecorder
+}
+
+// serverDefaultPageSize is the page size of a list request without size, as with meshStack.
+const serverDefaultPageSize = 20
+
+func NewServer(mock Client) *Server {
+	s := &Server{
+		Mock:      mock,
+		ApiKey:    "api-key",
+		ApiSecret: "api-secret",
+		routes:    newMeshObjectRoutes(mock),
+		tokens:    map[string]bool{},
+
+		idempotentResponses: map[string]*httptest.ResponseRecorder{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /mesh/info", s.handleMeshInfo)
+	mux.HandleFunc("POST /api/login", s.handleLogin)
+	mux.HandleFunc("/api/meshobjects/", s.handleMeshObjects)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func (s *Server) handleMeshInfo(w http.ResponseWriter, r *http.Request) {
+	info, err := s.Mock.MeshInfo.Read(r.Context())
+	if err != nil {
+		writeServerError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeServerJson(w, http.StatusOK, "application/json", info)
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var credentials struct {
+		ClientId     string `json:"clientId"`
+		ClientSecret string `json:"clientSecret"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+		writeServerError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if credentials.ClientId != s.ApiKey || credentials.ClientSecret != s.ApiSecret {
+		writeServerError(w, http.StatusUnauthorized, "invalid client credentials")
+		return
+	}
+	token := uuid.NewString()
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+	writeServerJson(w, http.StatusOK, "application/json", map[string]any{"access_token": token, "expires_in": 3600})
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ok && s.tokens[token]
+}
+
+func (s *Server) handleMeshObjects(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeServerError(w, http.StatusUnauthorized, "missing or invalid access token")
+		return
+	}
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+	if r.Method != http.MethodPost || idempotencyKey == "" {
+		s.serveMeshObjects(w, r)
+		return
+	}
+	s.mu.Lock()
+	response, seen := s.idempotentResponses[idempotencyKey]
+	s.mu.Unlock()
+	if !seen {
+		response = httptest.NewRecorder()
+		s.serveMeshObjects(response, r)
+		s.mu.Lock()
+		s.idempotentResponses[idempotencyKey] = response
+		s.mu.Unlock()
+	}
+	maps.Copy(w.Header(), response.Header())
+	w.WriteHeader(response.Code)
+	_, _ = w.Write(response.Body.Bytes())
+}
+
+func (s *Server) serveMeshObjects(w http.ResponseWriter, r *http.Request) {
+	apiPath := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/meshobjects/"), "/")
+	route, id, action, ok := s.route(apiPath, r.Header.Get("Accept"))
+	if !ok {
+		writeServerError(w, http.StatusNotFound, fmt.Sprintf("no meshObject API at %s", r.URL.Path))
+		return
+	}
+	apiVersion, ok := negotiateServerApiVersion(r.Header.Get("Accept"), route)
+	if !ok {
+		writeServerError(w, http.StatusNotAcceptable, fmt.Sprintf("%s is served as %s", route.kind, strings.Join(route.apiVersions, ", ")))
+		return
+	}
+	route = route.forApiVersion(apiVersion)
+	mimeType := fmt.Sprintf("application/vnd.meshcloud.api.%s.%s.hal+json", route.kind, apiVersion)
+
+	ctx := r.Context()
+	switch {
+	case action != "" && route.actions[r.Method+" "+action] != nil:
+		if existing, err := route.read(ctx, apiVersion, id); err != nil || existing == nil {
+			writeServerMeshObject(w, r, mimeType, http.StatusOK, existing, err)
+			return
+		}
+		if err := route.actions[r.Method+" "+action](ctx, apiVersion, id); err != nil {
+			writeServerErr(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case action != "":
+		writeServerError(w, http.StatusNotFound, fmt.Sprintf("no action %s %s for %s", r.Method, action, route.kind))
+	case r.Method == http.MethodGet && id == "" && route.list != nil:
+		items, err := route.list(ctx, apiVersion, r.URL.Query())
+		if errors.Is(err, errInvalidServerListQuery) {
+			writeServerError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err != nil {
+			writeServerError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		page, err := newServerPage(r, route.kind, items)
+		if err != nil {
+			writeServerError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeServerJson(w, http.StatusOK, mimeType, page)
+	case r.Method == http.MethodGet:
+		object, err := route.read(ctx, apiVersion, id)
+		writeServerMeshObject(w, r, mimeType, http.StatusOK, object, err)
+	case r.Method == http.MethodPost && id == "":
+		payload, ok := readServerPayload(w, r, route.kind)
+		if !ok {
+			return
+		}
+		if id := route.createdId(payload); id != "" {
+			if existing, err := route.read(ctx, apiVersion, id); err == nil && existing != nil {
+				writeServerError(w, http.StatusConflict, fmt.Sprintf("%s %s already exists", route.kind, id))
+				return
+			}
+		}
+		object, err := route.create(ctx, apiVersion, payload)
+		writeServerMeshObject(w, r, mimeType, http.StatusCreated, object, err)
+	case r.Method == http.MethodPut && id != "" && route.update != nil:
+		payload, ok := readServerPayload(w, r, route.kind)
+		if !ok {
+			return
+		}
+		if existing, err := route.read(ctx, apiVersion, id); err != nil || existing == nil {
+			writeServerMeshObject(w, r, mimeType, http.StatusOK, existing, err)
+			return
+		} else if err := checkIfMatch(&existing, r.Header.Get("If-Match")); err != nil {
+			writeServerErr(w, err)
+			return
+		}
+		object, err := route.update(ctx, apiVersion, id, payload)
+		writeServerMeshObject(w, r, mimeType, http.StatusOK, object, err)
+	case r.Method == http.MethodDelete && id != "":
+		if existing, err := route.read(ctx, apiVersion, id); err != nil || existing == nil {
+			writeServerMeshObject(w, r, mimeType, http.StatusOK, existing, err)
+			return
+		} else if err := checkIfMatch(&existing, r.Header.Get("If-Match")); err != nil {
+			writeServerErr(w, err)
+			return
+		}
+		if err := route.delete(ctx, apiVersion, id); err != nil {
+			writeServerErr(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeServerError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not supported for %s", r.Method, r.URL.Path))
+	}
+}
+
+// route finds the route of apiPath, which is the path of a route optionally followed by a meshObject id and
+// an action on it, e.g. meshbuildingblocks/{uuid}/purge.
+// Paths without a route are served by the MeshObjectClient route of the kind accepted by the request.
+func (s *Server) route(apiPath, accept string) (route meshObjectRoute, id, action string, ok bool) {
+	for path, route := range s.routes {
+		if apiPath == path {
+			return route, "", "", true
+		}
+		if rest, found := strings.CutPrefix(apiPath, path+"/"); found {
+			id, action, _ := strings.Cut(rest, "/")
+			if !strings.Contains(action, "/") {
+				return route, id, action, true
+			}
+		}
+	}
+	path, id, _ := strings.Cut(apiPath, "/")
+	if strings.Contains(id, "/") {
+		return route, "", "", false
+	}
+	for _, accepted := range parseServerAccept(accept) {
+		if match := meshObjectMimeTypeRe.FindStringSubmatch(accepted.mediaType); match != nil && strings.EqualFold(match[1]+"s", path) {
+			return newMeshObjectClientRoute(s.Mock.MeshObject, match[1]), id, "", true
+		}
+	}
+	return route, "", "", false
+}
+
+// writeServerMeshObject writes object with its ETag, see meshObjectETag.
+func writeServerMeshObject(w http.ResponseWriter, r *http.Request, mimeType string, status int, object any, err error) {
+	if err == nil && object != nil {
+		var etag string
+		if etag, err = meshObjectETag(object); err == nil {
+			w.Header().Set("ETag", etag)
+		}
+	}
+	switch {
+	case err != nil:
+		writeServerErr(w, err)
+	case object == nil:
+		writeServerError(w, http.StatusNotFound, fmt.Sprintf("%s not found", r.URL.Path))
+	default:
+		writeServerJson(w, status, mimeType, object)
+	}
+}
+
+// meshObjectRoute serves the meshObjects of a kind. Its functions get the negotiated API version, and read
+// returns nil if the meshObject does not exist. A kind without list or update does not support it.
+type meshObjectRoute struct {
+	kind string
+	// apiVersions are the versions served, the newest first. Any version is served if empty.
+	apiVersions []string
+	// versionRoutes serve the API versions the functions below do not, see forApiVersion.
+	versionRoutes map[string]meshObjectRoute
+	list          func(ctx context.Context, apiVersion string, query url.Values) ([]any, error)
+	read          func(ctx context.Context, apiVersion, id string) (any, error)
+	create        func(ctx context.Context, apiVersion string, payload []byte) (any, error)
+	update        func(ctx context.Context, apiVersion, id string, payload []byte) (any, error)
+	delete        func(ctx context.Context, apiVersion, id string) error
+	// createdId returns the id read returns the meshObject created from payload by, if it is not assigned by
+	// the backend, so that creating it again is a conflict. See serverMetadataName.
+	createdId func(payload []byte) string
+	// actions are the requests on a meshObject other than read, update and delete, e.g. "DELETE purge" for
+	// DELETE {id}/purge, by method and path.
+	actions map[string]func(ctx context.Context, apiVersion, id string) error
+}
+
+// forApiVersion returns the route serving apiVersion, which differs from r if r's kind is served in that
+// version through another mock client, like the v1 building blocks.
+func (r meshObjectRoute) forApiVersion(apiVersion string) meshObjectRoute {
+	if versionRoute, ok := r.versionRoutes[apiVersion]; ok {
+		return versionRoute
+	}
+	return r
+}
+
+// The list queries of the client that are not exported, by the same json tags.
+type (
+	serverProjectListQuery struct {
+		WorkspaceIdentifier string  `json:"workspaceIdentifier"`
+		PaymentIdentifier   *string `json:"paymentIdentifier"`
+	}
+	serverPlatformTypeListQuery struct {
+		Category        *string `json:"category"`
+		LifecycleStatus *string `json:"lifecycleStatus"`
+	}
+	serverBuildingBlockDefinitionListQuery struct {
+		IncludeAllPublished bool    `json:"includeAllPublished"`
+		OwnedByWorkspace    *string `json:"ownedByWorkspace"`
+	}
+)
+
+// newMeshObjectRoutes returns the routes of the kinds served through their mock client, by their API path.
+func newMeshObjectRoutes(mock Client) map[string]meshObjectRoute {
+	buildingBlocks := newTypedMeshObjectRoute("meshBuildingBlock", []string{"v2", "v2-preview", "v1"}, mock.BuildingBlockV2.List,
+		mock.BuildingBlockV2.Read, mock.BuildingBlockV2.Create,
+		func(ctx context.Context, _ string, buildingBlock *client.MeshBuildingBlockV2) (*client.MeshBuildingBlockV2, error) {
+			return mock.BuildingBlockV2.Update(ctx, buildingBlock)
+		},
+		func(ctx context.Context, uuid string) error {
+			return mock.BuildingBlockV2.Delete(ctx, uuid, false)
+		})
+	buildingBlocks.actions = map[string]func(ctx context.Context, apiVersion, id string) error{
+		"DELETE purge": func(ctx context.Context, _, uuid string) error {
+			return mock.BuildingBlockV2.Delete(ctx, uuid, true)
+		},
+		"POST trigger-run": func(ctx context.Context, _, uuid string) error {
+			return mock.BuildingBlockV2.TriggerRun(ctx, uuid)
+		},
+	}
+	buildingBlocks.versionRoutes = map[string]meshObjectRoute{
+		"v1": newTypedMeshObjectRoute[client.MeshBuildingBlock, client.MeshBuildingBlockCreate, struct{}]("meshBuildingBlock", []string{"v1"}, nil,
+			mock.BuildingBlock.Read, mock.BuildingBlock.Create, nil, mock.BuildingBlock.Delete),
+	}
+
+	projects := newTypedMeshObjectRoute("meshProject", []string{"v2"},
+		func(ctx context.Context, query serverProjectListQuery) ([]client.MeshProject, error) {
+			return mock.Project.List(ctx, query.WorkspaceIdentifier, query.PaymentIdentifier)
+		},
+		func(ctx context.Context, id string) (*client.MeshProject, error) {
+			workspace, name, _ := strings.Cut(id, ".")
+			return mock.Project.Read(ctx, workspace, name)
+		},
+		mock.Project.Create,
+		func(ctx context.Context, _ string, project *client.MeshProjectCreate) (*client.MeshProject, error) {
+			return mock.Project.Update(ctx, project)
+		},
+		func(ctx context.Context, id string) error {
+			workspace, name, _ := strings.Cut(id, ".")
+			return mock.Project.Delete(ctx, workspace, name)
+		})
+	// Projects are identified by their workspace and name, see the read above.
+	projects.createdId = func(payload []byte) string {
+		var project client.MeshProjectCreate
+		if json.Unmarshal(payload, &project) != nil {
+			return ""
+		}
+		return project.Metadata.OwnedByWorkspace + "." + project.Metadata.Name
+	}
+
+	return map[string]meshObjectRoute{
+		"meshbuildingblocks": buildingBlocks,
+		"meshbuildingblockdefinitions": newTypedMeshObjectRoute("meshBuildingBlockDefinition", []string{"v1", "v1-preview"},
+			func(ctx context.Context, query serverBuildingBlockDefinitionListQuery) ([]client.MeshBuildingBlockDefinition, error) {
+				return mock.BuildingBlockDefinition.List(ctx, query.OwnedByWorkspace)
+			},
+			mock.BuildingBlockDefinition.Read,
+			func(ctx context.Context, definition *client.MeshBuildingBlockDefinition) (*client.MeshBuildingBlockDefinition, error) {
+				return mock.BuildingBlockDefinition.Create(ctx, *definition)
+			},
+			func(ctx context.Context, uuid string, definition *client.MeshBuildingBlockDefinition) (*client.MeshBuildingBlockDefinition, error) {
+				return mock.BuildingBlockDefinition.Update(ctx, uuid, *definition)
+			},
+			mock.BuildingBlockDefinition.Delete),
+		"meshworkspaces": newTypedMeshObjectRoute("meshWorkspace", []string{"v2"},
+			func(ctx context.Context, _ struct{}) ([]client.MeshWorkspace, error) {
+				return mock.Workspace.List(ctx)
+			},
+			mock.Workspace.Read, mock.Workspace.Create, mock.Workspace.Update, mock.Workspace.Delete),
+		"meshprojects": projects,
+		"meshtenants": newTypedMeshObjectRoute[client.MeshTenant, client.MeshTenantCreate]("meshTenant", []string{"v4"}, mock.Tenant.List,
+			mock.Tenant.Read, mock.Tenant.Create, nil, mock.Tenant.Delete),
+		"meshlandingzones": newTypedMeshObjectRoute("meshLandingZone", []string{"v1"}, mock.LandingZone.List,
+			mock.LandingZone.Read, mock.LandingZone.Create, mock.LandingZone.Update, mock.LandingZone.Delete),
+		"meshlocations": newTypedMeshObjectRoute("meshLocation", []string{"v1"}, listServerStore(mock.Location.Store),
+			mock.Location.Read, mock.Location.Create, mock.Location.Update, mock.Location.Delete),
+		"meshpaymentmethods": newTypedMeshObjectRoute("meshPaymentMethod", []string{"v2"}, listServerStore(mock.PaymentMethod.Store),
+			func(ctx context.Context, id string) (*client.MeshPaymentMethod, error) {
+				return mock.PaymentMethod.Read(ctx, "", id)
+			},
+			mock.PaymentMethod.Create, mock.PaymentMethod.Update, mock.PaymentMethod.Delete),
+		"meshplatforms": newTypedMeshObjectRoute("meshPlatform", []string{"v2"}, mock.Platform.List,
+			mock.Platform.Read,
+			func(ctx context.Context, platform *client.MeshPlatform) (*client.MeshPlatform, error) {
+				return mock.Platform.Create(ctx, *platform)
+			},
+			func(ctx context.Context, uuid string, platform *client.MeshPlatform) (*client.MeshPlatform, error) {
+				return mock.Platform.Update(ctx, uuid, *platform)
+			},
+			mock.Platform.Delete),
+		"meshplatformtypes": newTypedMeshObjectRoute("meshPlatformType", []string{"v1"},
+			func(ctx context.Context, query serverPlatformTypeListQuery) ([]client.MeshPlatformType, error) {
+				return mock.PlatformType.List(ctx, query.Category, query.LifecycleStatus)
+			},
+			mock.PlatformType.Read, mock.PlatformType.Create, mock.PlatformType.Update, mock.PlatformType.Delete),
+		"meshtagdefinitions": newTypedMeshObjectRoute("meshTagDefinition", []string{"v1"},
+			func(ctx context.Context, _ struct{}) ([]client.MeshTagDefinition, error) {
+				return mock.TagDefinition.List(ctx)
+			},
+			mock.TagDefinition.Read, mock.TagDefinition.Create,
+			func(ctx context.Context, _ string, tagDefinition *client.MeshTagDefinition) (*client.MeshTagDefinition, error) {
+				return mock.TagDefinition.Update(ctx, tagDefinition)
+			},
+			mock.TagDefinition.Delete),
+		"meshprojectbindings/userbindings": newTypedMeshObjectRoute[client.MeshProjectUserBinding, client.MeshProjectUserBinding, struct{}](
+			"meshProjectUserBinding", []string{"v3"}, nil,
+			mock.ProjectUserBinding.Read, mock.ProjectUserBinding.Create, nil, mock.ProjectUserBinding.Delete),
+		"meshprojectbindings/groupbindings": newTypedMeshObjectRoute[client.MeshProjectGroupBinding, client.MeshProjectGroupBinding, struct{}](
+			"meshProjectGroupBinding", []string{"v3"}, nil,
+			mock.ProjectGroupBinding.Read, mock.ProjectGroupBinding.Create, nil, mock.ProjectGroupBinding.Delete),
+		"meshworkspacebindings/userbindings": newTypedMeshObjectRoute[client.MeshWorkspaceUserBinding, client.MeshWorkspaceUserBinding, struct{}](
+			"meshWorkspaceUserBinding", []string{"v2"}, nil,
+			mock.WorkspaceUserBinding.Read, mock.WorkspaceUserBinding.Create, nil, mock.WorkspaceUserBinding.Delete),
+		"meshworkspacebindings/groupbindings": newTypedMeshObjectRoute[client.MeshWorkspaceGroupBinding, client.MeshWorkspaceGroupBinding, struct{}](
+			"meshWorkspaceGroupBinding", []string{"v2"}, nil,
+			mock.WorkspaceGroupBinding.Read, mock.WorkspaceGroupBinding.Create, nil, mock.WorkspaceGroupBinding.Delete),
+	}
+}
+
+// listServerStore lists all meshObjects of store, for the mock clients without a List. They take no filters.
+func listServerStore[M any](store *Store[M]) func(ctx context.Context, query struct{}) ([]M, error) {
+	return func(context.Context, struct{}) ([]M, error) {
+		items := []M{}
+		for _, key := range store.SortedKeys() {
+			if object, ok := store.Get(key); ok {
+				items = append(items, *object)
+			}
+		}
+		return items, nil
+	}
+}
+
+// serverMetadataName returns the metadata.name of a create payload, which identifies the meshObjects of most
+// kinds. Read does not find a meshObject by the name if it is identified by a uuid instead.
+func serverMetadataName(payload []byte) string {
+	var object struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	_ = json.Unmarshal(payload, &object) // an invalid payload is rejected by create
+	return object.Metadata.Name
+}
+
+// newTypedMeshObjectRoute serves the meshObjects of type M through the functions of its mock client, which
+// take the payload as type C and the filters of a list as type Q, see decodeServerListQuery. A nil list or
+// update is not supported by the kind.
+func newTypedMeshObjectRoute[M, C, Q any](
+	kind string,
+	apiVersions []string,
+	list func(ctx context.Context, query Q) ([]M, error),
+	read func(ctx context.Context, id string) (*M, error),
+	create func(ctx context.Context, payload *C) (*M, error),
+	update func(ctx context.Context, id string, payload *C) (*M, error),
+	deleteFunc func(ctx context.Context, id string) error,
+) meshObjectRoute {
+	asAny := func(object *M, err error) (any, error) {
+		if object == nil || err != nil {
+			return nil, err
+		}
+		return object, nil
+	}
+	route := meshObjectRoute{
+		kind:        kind,
+		apiVersions: apiVersions,
+		createdId:   serverMetadataName,
+		read: func(ctx context.Context, _, id string) (any, error) {
+			return asAny(read(ctx, id))
+		},
+		create: func(ctx context.Context, _ string, payload []byte) (any, error) {
+			var decoded C
+			if err := json.Unmarshal(payload, &decoded); err != nil {
+				return nil, err
+			}
+			return asAny(create(ctx, &decoded))
+		},
+		delete: func(ctx context.Context, _, id string) error {
+			return deleteFunc(ctx, id)
+		},
+	}
+	if list != nil {
+		route.list = func(ctx context.Context, _ string, query url.Values) ([]any, error) {
+			decodedQuery, err := decodeServerListQuery[Q](query)
+			if err != nil {
+				return nil, err
+			}
+			objects, err := list(ctx, decodedQuery)
+			if err != nil {
+				return nil, err
+			}
+			return sortServerList(objects)
+		}
+	}
+	if update != nil {
+		route.update = func(ctx context.Context, _, id string, payload []byte) (any, error) {
+			var decoded C
+			if err := json.Unmarshal(payload, &decoded); err != nil {
+				return nil, err
+			}
+			return asAny(update(ctx, id, &decoded))
+		}
+	}
+	return route
+}
+
+var errInvalidServerListQuery = errors.New("invalid list query")
+
+// decodeServerListQuery decodes the filters of a list request into Q, whose json tags name the query params
+// like those of client.MeshTenantQuery. Unlike meshStack, it rejects unknown params, so that a typo in a
+// param name fails the test instead of silently disabling the filter.
+func decodeServerListQuery[Q any](query url.Values) (decoded Q, err error) {
+	fields := map[string]reflect.Value{}
+	value := reflect.ValueOf(&decoded).Elem()
+	for i := range value.NumField() {
+		name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+		fields[name] = value.Field(i)
+	}
+	for param, values := range query {
+		if param == "page" || param == "size" {
+			continue
+		}
+		field, ok := fields[param]
+		if !ok {
+			return decoded, fmt.Errorf("%w: unknown param '%s'", errInvalidServerListQuery, param)
+		}
+		// A string field takes the param as JSON string, any other one (e.g. a bool) as JSON literal.
+		target := field.Addr().Interface()
+		if json.Unmarshal(strconv.AppendQuote(nil, values[0]), target) != nil && json.Unmarshal([]byte(values[0]), target) != nil {
+			return decoded, fmt.Errorf("%w: invalid value '%s' of param '%s'", errInvalidServerListQuery, values[0], param)
+		}
+	}
+	return decoded, nil
+}
+
+// sortServerList returns objects as JSON in a stable order, as the mock clients list them in map order,
+// which would make pages overlap.
+func sortServerList[M any](objects []M) ([]any, error) {
+	sorted := make([]json.RawMessage, len(objects))
+	for i, object := range objects {
+		data, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		sorted[i] = data
+	}
+	slices.SortFunc(sorted, func(a, b json.RawMessage) int {
+		return bytes.Compare(a, b)
+	})
+	items := make([]any, len(sorted))
+	for i, object := range sorted {
+		items[i] = object
+	}
+	return items, nil
+}
+
+// newMeshObjectClientRoute serves any kind as plain JSON through MeshObjectClient.
+func newMeshObjectClientRoute(meshObjects MeshObjectClient, kind string) meshObjectRoute {
+	asAny := func(object json.RawMessage, err error) (any, error) {
+		if object == nil || err != nil {
+			return nil, err
+		}
+		return object, nil
+	}
+	return meshObjectRoute{
+		kind:      kind,
+		createdId: serverMetadataName,
+		list: func(ctx context.Context, apiVersion string, _ url.Values) ([]any, error) {
+			objects, err := meshObjects.List(ctx, kind, apiVersion, nil)
+			items := make([]any, len(objects))
+			for i, object := range objects {
+				items[i] = object
+			}
+			return items, err
+		},
+		read: func(ctx context.Context, apiVersion, id string) (any, error) {
+			return asAny(meshObjects.Read(ctx, kind, apiVersion, id))
+		},
+		create: func(ctx context.Context, apiVersion string, payload []byte) (any, error) {
+			return asAny(meshObjects.Create(ctx, kind, apiVersion, payload))
+		},
+		update: func(ctx context.Context, apiVersion, id string, payload []byte) (any, error) {
+			return asAny(meshObjects.Update(ctx, kind, apiVersion, id, payload))
+		},
+		delete: func(ctx context.Context, apiVersion, id string) error {
+			return meshObjects.Delete(ctx, kind, apiVersion, id)
+		},
+	}
+}
+
+var meshObjectMimeTypeRe = regexp.MustCompile(`^application/vnd\.meshcloud\.api\.(mesh[A-Za-z]+)\.(v[0-9]+(?:-preview)?)\.hal\+json$`)
+
+type serverAcceptedMediaType struct {
+	mediaType string
+	quality   float64
+}
+
+// parseServerAccept returns the media types of an Accept header, the preferred ones first.
+func parseServerAccept(accept string) (accepted []serverAcceptedMediaType) {
+	for mediaRange := range strings.SplitSeq(accept, ",") {
+		_, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		// ParseMediaType lowercases the media type, but the kind in it is camel case.
+		mediaType, _, _ := strings.Cut(mediaRange, ";")
+		mediaType = strings.TrimSpace(mediaType)
+		quality := 1.0
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			quality = q
+		}
+		accepted = append(accepted, serverAcceptedMediaType{mediaType, quality})
+	}
+	slices.SortStableFunc(accepted, func(a, b serverAcceptedMediaType) int {
+		return cmp.Compare(b.quality, a.quality)
+	})
+	return accepted
+}
+
+// negotiateServerApiVersion picks the API version of route most preferred by the Accept header. The newest
+// one is used if any JSON is accepted.
+func negotiateServerApiVersion(accept string, route meshObjectRoute) (string, bool) {
+	if accept == "" && len(route.apiVersions) > 0 {
+		return route.apiVersions[0], true
+	}
+	for _, accepted := range parseServerAccept(accept) {
+		if match := meshObjectMimeTypeRe.FindStringSubmatch(accepted.mediaType); match != nil {
+			if strings.EqualFold(match[1], route.kind) && (len(route.apiVersions) == 0 || slices.Contains(route.apiVersions, match[2])) {
+				return match[2], true
+			}
+		} else if len(route.apiVersions) > 0 && slices.Contains([]string{"*/*", "application/*", "application/json"}, accepted.mediaType) {
+			return route.apiVersions[0], true
+		}
+	}
+	return "", false
+}
+
+// readServerPayload reads the meshObject payload of a POST or PUT, which must be of the route's kind.
+func readServerPayload(w http.ResponseWriter, r *http.Request, kind string) ([]byte, bool) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeServerError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	var header struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(payload, &header); err != nil {
+		writeServerError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	if header.Kind != kind {
+		writeServerError(w, http.StatusBadRequest, fmt.Sprintf("expected kind %s, got '%s'", kind, header.Kind))
+		return nil, false
+	}
+	return payload, true
+}
+
+// newServerPage returns the requested page of items in meshStack's HAL format.
+func newServerPage(r *http.Request, kind string, items []any) (any, error) {
+	query := r.URL.Query()
+	number, size := 0, serverDefaultPageSize
+	var err error
+	if page := query.Get("page"); page != "" {
+		if number, err = strconv.Atoi(page); err != nil || number < 0 {
+			return nil, fmt.Errorf("invalid page '%s'", page)
+		}
+	}
+	if pageSize := query.Get("size"); pageSize != "" {
+		if size, err = strconv.Atoi(pageSize); err != nil || size < 1 {
+			return nil, fmt.Errorf("invalid size '%s'", pageSize)
+		}
+	}
+	start, end := min(number*size, len(items)), min((number+1)*size, len(items))
+	return map[string]any{
+		"_embedded": map[string]any{kind + "s": items[start:end]},
+		"page": map[string]any{
+			"size":          size,
+			"totalElements": len(items),
+			"totalPages":    int(math.Ceil(float64(len(items)) / float64(size))),
+			"number":        number,
+		},
+		"_links": map[string]any{"self": map[string]any{"href": r.URL.String()}},
+	}, nil
+}
+
+func writeServerJson(w http.ResponseWriter, status int, contentType string, body any) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		status, contentType = http.StatusInternalServerError, "application/json"
+		data, _ = json.Marshal(map[string]string{"message": err.Error()})
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// writeServerError writes meshStack's error format.
+func writeServerError(w http.ResponseWriter, status int, message string) {
+	writeServerJson(w, status, "application/json", map[string]string{"message": message})
+}
+
+// writeServerErr writes an error of a mock client. A client.HttpError, e.g. the 412 Precondition Failed of
+// checkIfMatch, keeps its status code, any other error is a 400 Bad Request.
+func writeServerErr(w http.ResponseWriter, err error) {
+	if httpErr, ok := errors.AsType[client.HttpError](err); ok {
+		writeServerError(w, httpErr.StatusCode, cmp.Or(httpErr.Message, http.StatusText(httpErr.StatusCode)))
+		return
+	}
+	writeServerError(w, http.StatusBadRequest, err.Error())
+}
@@ -0,0 +1,314 @@
+package clientmock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+func newServerClient(t *testing.T, server *Server, apiKey, apiSecret string) (client.Client, error) {
+	t.Helper()
+	rootUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return client.New(t.Context(), rootUrl, "test", client.NewApiKeyAuthorization(apiKey, apiSecret))
+}
+
+func TestServer(t *testing.T) {
+	server := NewServer(NewMock())
+	t.Cleanup(server.Close)
+	c, err := newServerClient(t, server, server.ApiKey, server.ApiSecret)
+	require.NoError(t, err)
+
+	t.Run("mesh info", func(t *testing.T) {
+		info, err := c.MeshInfo.Read(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, client.MinMeshStackVersion.String(), info.Version)
+	})
+
+	t.Run("typed meshObject", func(t *testing.T) {
+		workspace, err := c.Workspace.Create(t.Context(), &client.MeshWorkspaceCreate{
+			Metadata: client.MeshWorkspaceCreateMetadata{Name: "server", Tags: map[string][]string{"env": {"dev"}}},
+			Spec:     client.MeshWorkspaceSpec{DisplayName: "Server"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "server", workspace.Metadata.Name)
+		assert.NotEmpty(t, workspace.Metadata.CreatedOn)
+
+		_, err = c.Workspace.Update(t.Context(), "server", &client.MeshWorkspaceCreate{
+			Metadata: client.MeshWorkspaceCreateMetadata{Name: "server"},
+			Spec:     client.MeshWorkspaceSpec{DisplayName: "Updated Server"},
+		})
+		require.NoError(t, err)
+		workspace, err = c.Workspace.Read(t.Context(), "server")
+		require.NoError(t, err)
+		require.NotNil(t, workspace)
+		assert.Equal(t, "Updated Server", workspace.Spec.DisplayName)
+		stored, _ := server.Mock.Workspace.Store.Get("server")
+		assert.Equal(t, "Updated Server", stored.Spec.DisplayName)
+
+		_, err = c.Workspace.Update(t.Context(), "missing", &client.MeshWorkspaceCreate{})
+		assert.ErrorContains(t, err, "404")
+		require.NoError(t, c.Workspace.Delete(t.Context(), "server"))
+		workspace, err = c.Workspace.Read(t.Context(), "server")
+		require.NoError(t, err)
+		assert.Nil(t, workspace)
+	})
+
+	t.Run("conditional writes", func(t *testing.T) {
+		_, err := c.LandingZone.Create(t.Context(), &client.MeshLandingZoneCreate{
+			Metadata: client.MeshLandingZoneMetadata{Name: "conditional", OwnedByWorkspace: "server", Tags: map[string][]string{}},
+			Spec:     client.MeshLandingZoneSpec{DisplayName: "Conditional"},
+		})
+		require.NoError(t, err)
+		_, etag, err := c.LandingZone.ReadWithETag(t.Context(), "conditional")
+		require.NoError(t, err)
+		require.NotEmpty(t, etag)
+
+		update := func(etag, displayName string) (string, error) {
+			_, newETag, err := c.LandingZone.UpdateIfMatch(t.Context(), "conditional", etag, &client.MeshLandingZoneCreate{
+				Metadata: client.MeshLandingZoneMetadata{Name: "conditional", OwnedByWorkspace: "server", Tags: map[string][]string{}},
+				Spec:     client.MeshLandingZoneSpec{DisplayName: displayName},
+			})
+			return newETag, err
+		}
+		newETag, err := update(etag, "Updated")
+		require.NoError(t, err)
+		assert.NotEqual(t, etag, newETag)
+		_, err = update(etag, "Lost update")
+		httpErr, ok := errors.AsType[client.HttpError](err)
+		require.True(t, ok, "%v", err)
+		assert.True(t, httpErr.IsPreconditionFailed())
+		stored, _ := server.Mock.LandingZone.Store.Get("conditional")
+		assert.Equal(t, "Updated", stored.Spec.DisplayName)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		assertConflict := func(t *testing.T, err error) {
+			t.Helper()
+			httpErr, ok := errors.AsType[client.HttpError](err)
+			require.True(t, ok, "%v", err)
+			assert.True(t, httpErr.IsConflict())
+		}
+		workspace := &client.MeshWorkspaceCreate{Metadata: client.MeshWorkspaceCreateMetadata{Name: "conflict"}}
+		_, err := c.Workspace.Create(t.Context(), workspace)
+		require.NoError(t, err)
+		_, err = c.Workspace.Create(t.Context(), workspace)
+		assertConflict(t, err)
+
+		project := &client.MeshProjectCreate{Metadata: client.MeshProjectCreateMetadata{Name: "conflict", OwnedByWorkspace: "conflict"}}
+		_, err = c.Project.Create(t.Context(), project)
+		require.NoError(t, err)
+		_, err = c.Project.Create(t.Context(), project)
+		assertConflict(t, err)
+		project.Metadata.OwnedByWorkspace = "other"
+		_, err = c.Project.Create(t.Context(), project)
+		require.NoError(t, err)
+
+		_, err = c.MeshObject.Create(t.Context(), "meshWorkspaceRole", "v1", []byte(`{"metadata":{"name":"conflict"}}`))
+		require.NoError(t, err)
+		_, err = c.MeshObject.Create(t.Context(), "meshWorkspaceRole", "v1", []byte(`{"metadata":{"name":"conflict"}}`))
+		assertConflict(t, err)
+	})
+
+	t.Run("idempotency key", func(t *testing.T) {
+		post := func(idempotencyKey string) (status int, body string) {
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL+"/api/meshobjects/meshworkspaces",
+				strings.NewReader(`{"apiVersion":"v2","kind":"meshWorkspace","metadata":{"name":"idempotent"},"spec":{"displayName":"Idempotent"}}`))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+serverToken(t, server))
+			req.Header.Set("Content-Type", "application/vnd.meshcloud.api.meshWorkspace.v2.hal+json")
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			data, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp.StatusCode, string(data)
+		}
+		status, created := post("some-key")
+		require.Equal(t, http.StatusCreated, status, created)
+		status, replayed := post("some-key")
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, created, replayed)
+		status, _ = post("other-key")
+		assert.Equal(t, http.StatusConflict, status)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		for i := range 2*serverDefaultPageSize + 1 {
+			_, err := c.Project.Create(t.Context(), &client.MeshProjectCreate{
+				Metadata: client.MeshProjectCreateMetadata{Name: fmt.Sprintf("project-%02d", i), OwnedByWorkspace: "server"},
+				Spec:     client.MeshProjectSpec{DisplayName: fmt.Sprintf("Project %d", i)},
+			})
+			require.NoError(t, err)
+		}
+		projects, err := c.Project.List(t.Context(), "server", nil)
+		require.NoError(t, err)
+		require.Len(t, projects, 2*serverDefaultPageSize+1)
+		assert.Equal(t, "project-00", projects[0].Metadata.Name)
+		assert.Equal(t, "project-40", projects[2*serverDefaultPageSize].Metadata.Name)
+
+		project, err := c.Project.Read(t.Context(), "server", "project-07")
+		require.NoError(t, err)
+		require.NotNil(t, project)
+		assert.Equal(t, "Project 7", project.Spec.DisplayName)
+	})
+
+	t.Run("filtered lists", func(t *testing.T) {
+		for _, workspace := range []string{"filtered", "other"} {
+			_, err := c.Tenant.Create(t.Context(), &client.MeshTenantCreate{
+				Metadata: client.MeshTenantCreateMetadata{OwnedByWorkspace: workspace, OwnedByProject: "project"},
+				Spec:     client.MeshTenantCreateSpec{PlatformRef: client.UuidRef{Uuid: "platform", Kind: "meshPlatform"}},
+			})
+			require.NoError(t, err)
+			_, err = c.Platform.Create(t.Context(), client.MeshPlatform{
+				Metadata: client.MeshPlatformMetadata{Name: "platform-" + workspace, OwnedByWorkspace: workspace},
+				Spec:     client.MeshPlatformSpec{Availability: client.PlatformAvailability{PublicationState: "PUBLISHED"}},
+			})
+			require.NoError(t, err)
+			_, err = c.BuildingBlockV2.Create(t.Context(), &client.MeshBuildingBlockV2{
+				Spec: client.MeshBuildingBlockV2Spec{
+					DisplayName: "building-block-" + workspace,
+					TargetRef:   client.MeshBuildingBlockV2TargetRef{Kind: client.MeshObjectKind.Workspace, Name: &workspace},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		tenants, err := c.Tenant.List(t.Context(), client.MeshTenantQuery{Workspace: "filtered", Project: new("project")})
+		require.NoError(t, err)
+		require.Len(t, tenants, 1)
+		assert.Equal(t, "filtered", tenants[0].Metadata.OwnedByWorkspace)
+		tenants, err = c.Tenant.List(t.Context(), client.MeshTenantQuery{Workspace: "filtered", Project: new("missing")})
+		require.NoError(t, err)
+		assert.Empty(t, tenants)
+
+		platforms, err := c.Platform.List(t.Context(), client.MeshPlatformListQuery{OwnedByWorkspace: new("filtered"), PublicationState: new("PUBLISHED")})
+		require.NoError(t, err)
+		require.Len(t, platforms, 1)
+		assert.Equal(t, "platform-filtered", platforms[0].Metadata.Name)
+
+		buildingBlocks, err := c.BuildingBlockV2.List(t.Context(), client.MeshBuildingBlockV2ListFilter{WorkspaceIdentifier: new("filtered")})
+		require.NoError(t, err)
+		require.Len(t, buildingBlocks, 1)
+		assert.Equal(t, "building-block-filtered", buildingBlocks[0].Spec.DisplayName)
+	})
+
+	t.Run("building block actions", func(t *testing.T) {
+		buildingBlock, err := c.BuildingBlockV2.Create(t.Context(), &client.MeshBuildingBlockV2{
+			Spec: client.MeshBuildingBlockV2Spec{
+				DisplayName: "actions",
+				TargetRef:   client.MeshBuildingBlockV2TargetRef{Kind: client.MeshObjectKind.Workspace, Name: new("server")},
+			},
+		})
+		require.NoError(t, err)
+		uuid := *buildingBlock.Metadata.Uuid
+
+		require.NoError(t, c.BuildingBlockV2.TriggerRun(t.Context(), uuid))
+		triggered, err := c.BuildingBlockV2.Read(t.Context(), uuid)
+		require.NoError(t, err)
+		require.NotNil(t, triggered)
+		assert.NotEqual(t, buildingBlock.Status.LatestRunUuid, triggered.Status.LatestRunUuid)
+
+		require.NoError(t, c.BuildingBlockV2.Delete(t.Context(), uuid, true))
+		purged, err := c.BuildingBlockV2.Read(t.Context(), uuid)
+		require.NoError(t, err)
+		assert.Nil(t, purged)
+		assert.ErrorContains(t, c.BuildingBlockV2.TriggerRun(t.Context(), uuid), "404")
+	})
+
+	t.Run("bindings", func(t *testing.T) {
+		binding, err := c.ProjectUserBinding.Create(t.Context(), &client.MeshProjectUserBinding{MeshProjectBinding: client.MeshProjectBinding{
+			Metadata:  client.MeshProjectBindingMetadata{Name: "binding"},
+			TargetRef: client.MeshProjectTargetRef{Name: "project", OwnedByWorkspace: "server"},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, "binding", binding.Metadata.Name)
+		stored, _ := server.Mock.ProjectUserBinding.Store.Get("binding")
+		require.NotNil(t, stored)
+		assert.Equal(t, "project", stored.TargetRef.Name)
+
+		require.NoError(t, c.ProjectUserBinding.Delete(t.Context(), "binding"))
+		binding, err = c.ProjectUserBinding.Read(t.Context(), "binding")
+		require.NoError(t, err)
+		assert.Nil(t, binding)
+	})
+
+	t.Run("any meshObject kind", func(t *testing.T) {
+		created, err := c.MeshObject.Create(t.Context(), "meshProjectRole", "v1", []byte(`{"metadata":{"name":"auditor"},"spec":{"displayName":"Auditor"}}`))
+		require.NoError(t, err)
+		assert.Contains(t, string(created), `"apiVersion":"v1"`)
+
+		read, err := c.MeshObject.Read(t.Context(), "meshProjectRole", "v1", "auditor")
+		require.NoError(t, err)
+		assert.JSONEq(t, string(created), string(read))
+		items, err := c.MeshObject.List(t.Context(), "meshProjectRole", "v1", nil)
+		require.NoError(t, err)
+		assert.Len(t, items, 1)
+
+		missing, err := c.MeshObject.Read(t.Context(), "meshProjectRole", "v1", "missing")
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		t.Setenv("MESHSTACK_SKIP_VERSION_CHECK", "true")
+		c, err := newServerClient(t, server, server.ApiKey, "wrong")
+		require.NoError(t, err)
+		_, err = c.Workspace.Read(t.Context(), "server")
+		assert.ErrorContains(t, err, "invalid client credentials")
+	})
+}
+
+// serverToken logs in to server with its credentials and returns the access token.
+func serverToken(t *testing.T, server *Server) string {
+	t.Helper()
+	resp, err := server.Client().Post(server.URL+"/api/login", "application/json",
+		strings.NewReader(fmt.Sprintf(`{"clientId":%q,"clientSecret":%q}`, server.ApiKey, server.ApiSecret)))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+	return token.AccessToken
+}
+
+func TestNegotiateServerApiVersion(t *testing.T) {
+	route := meshObjectRoute{kind: "meshBuildingBlock", apiVersions: []string{"v2", "v1"}}
+	for accept, expected := range map[string]string{
+		"": "v2",
+		"application/vnd.meshcloud.api.meshBuildingBlock.v1.hal+json":                                                                    "v1",
+		"application/vnd.meshcloud.api.meshBuildingBlock.v3.hal+json, application/vnd.meshcloud.api.meshBuildingBlock.v1.hal+json;q=0.9": "v1",
+		"application/vnd.meshcloud.api.meshBuildingBlock.v1.hal+json;q=0.5, application/vnd.meshcloud.api.meshBuildingBlock.v2.hal+json": "v2",
+		"application/json": "v2",
+		"application/vnd.meshcloud.api.meshWorkspace.v2.hal+json": "",
+	} {
+		apiVersion, ok := negotiateServerApiVersion(accept, route)
+		assert.Equal(t, expected != "", ok, accept)
+		assert.Equal(t, expected, apiVersion, accept)
+	}
+}
+
+func TestDecodeServerListQuery(t *testing.T) {
+	query, err := decodeServerListQuery[client.MeshLandingZoneListQuery](url.Values{
+		"identifier": {"true"}, "restricted": {"true"}, "page": {"1"}, "size": {"20"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, client.MeshLandingZoneListQuery{Identifier: new("true"), Restricted: new(true)}, query)
+
+	for _, values := range []url.Values{{"identifiers": {"a"}}, {"restricted": {"yes"}}} {
+		_, err := decodeServerListQuery[client.MeshLandingZoneListQuery](values)
+		assert.ErrorIs(t, err, errInvalidServerListQuery, values)
+	}
+}
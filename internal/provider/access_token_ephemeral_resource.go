@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &accessTokenEphemeralResource{}
+)
+
+// accessTokenRenewBefore is how long before the token expires Terraform calls Renew, so that the warning
+// about the expiring token is reported while the operations using it are still running.
+const accessTokenRenewBefore = time.Minute
+
+// accessTokenPrivateExpiresAt is the private data key keeping the expiry for Renew, which gets no config.
+const accessTokenPrivateExpiresAt = "expires_at"
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+type accessTokenEphemeralResource struct {
+	accessTokenClient client.AccessTokenClient
+}
+
+type accessTokenEphemeralResourceModel struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Token        types.String `tfsdk:"token"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+func (r *accessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *accessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Logs in with an API key and provides the resulting meshStack access token, e.g. for a building block runner " +
+			"or a script calling the meshStack API during the run. The token is never stored in plan or state.\n\n" +
+			"meshStack access tokens cannot be extended. When a run outlives the token, Terraform calls the renewal of this ephemeral " +
+			"resource shortly before the token expires, which reports a warning, as the token already handed out stays as it is.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID of the API key, e.g. `meshstack_api_key.example.status.client_id`.",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the API key.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The access token, to be sent as `Authorization: Bearer <token>`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the access token expires, as RFC 3339 timestamp.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *accessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.accessTokenClient = client.AccessToken
+	})...)
+}
+
+func (r *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data accessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessToken, err := r.accessTokenClient.Login(ctx, data.ClientId.ValueString(), data.ClientSecret.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not obtain access token", err.Error())
+		return
+	}
+	expiresAt := accessToken.ExpiresAt.UTC().Format(time.RFC3339)
+	data.Token = types.StringValue(accessToken.Token)
+	data.ExpiresAt = types.StringValue(expiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	privateExpiresAt, _ := json.Marshal(expiresAt) // cannot fail for a string
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessTokenPrivateExpiresAt, privateExpiresAt)...)
+	resp.RenewAt = accessToken.ExpiresAt.Add(-accessTokenRenewBefore)
+}
+
+// Renew cannot replace the token, as Terraform keeps the result of Open, and meshStack cannot extend it.
+// It warns instead, so that a failure of whatever still uses the token is not a mystery.
+func (r *accessTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	privateExpiresAt, diags := req.Private.GetKey(ctx, accessTokenPrivateExpiresAt)
+	resp.Diagnostics.Append(diags...)
+	var expiresAt string
+	if err := json.Unmarshal(privateExpiresAt, &expiresAt); err != nil {
+		resp.Diagnostics.AddError("Could not read expiry of access token", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.AddWarning("Access token expires",
+		fmt.Sprintf("The meshStack access token expires at %s and cannot be renewed. Requests still using it afterwards fail. "+
+			"Open the access token in a shorter run, e.g. by splitting the configuration.", expiresAt))
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+// TestAccessTokenEphemeralResource hands the token to the echo provider, as an ephemeral result is not in the state.
+func TestAccessTokenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	mockClient := clientmock.NewMock()
+	apiKey, err := mockClient.ApiKey.Create(t.Context(), &client.MeshApiKey{Spec: client.MeshApiKeySpec{DisplayName: "ci-key"}})
+	require.NoError(t, err)
+
+	providerFactories := ProviderFactoriesForTest(func(provider *MeshStackProvider) {
+		provider.clientFactory = func(ctx context.Context, data MeshStackProviderModel, providerVersion string) (client.Client, diag.Diagnostics) {
+			return mockClient.AsClient(), nil
+		}
+	})
+	providerFactories["echo"] = echoprovider.NewProviderServer()
+
+	config := func(clientId string) string {
+		return fmt.Sprintf(`ephemeral "meshstack_access_token" "example" {
+  client_id     = %q
+  client_secret = %q
+}
+
+provider "echo" {
+  data = ephemeral.meshstack_access_token.example
+}
+
+resource "echo" "test" {}
+`, clientId, *apiKey.Status.ClientSecret)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_10_0)},
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(apiKey.Status.ClientId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("echo.test", "data.token", regexp.MustCompile(`^token-`)),
+					resource.TestCheckResourceAttrSet("echo.test", "data.expires_at"),
+				),
+			},
+			{
+				Config:      config("unknown-client-id"),
+				ExpectError: regexp.MustCompile(`Could not obtain access token`),
+			},
+		},
+	})
+}
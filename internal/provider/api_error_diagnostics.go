@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// pathMatcher is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config.
+type pathMatcher interface {
+	PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics)
+}
+
+// addApiErrorDiagnostics reports a failed meshStack API call. Each field violation meshStack reports
+// is attached to the offending attribute of data (usually the plan) with AddAttributeError, so that
+// Terraform points at it in the configuration. They follow an error with the status, error code and
+// message of the response, see client.HttpError.Headline. Violations of fields which have no matching
+// attribute, and errors without violations, are reported with AddError as before. A 412 Precondition
+// Failed of a conditional write is explained with objectChangedDetail.
+func addApiErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, data pathMatcher, summary string, err error) {
+	httpErr, ok := errors.AsType[client.HttpError](err)
+	if ok && httpErr.IsPreconditionFailed() {
+		diags.AddError(summary, objectChangedDetail)
+		return
+	}
+	if !ok || len(httpErr.Violations) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+	diags.AddError(summary, httpErr.Headline())
+	for _, violation := range httpErr.Violations {
+		if attributePath, ok := apiFieldAttributePath(ctx, data, violation.Field); ok {
+			diags.AddAttributeError(attributePath, summary, violation.String())
+		} else {
+			diags.AddError(summary, violation.String())
+		}
+	}
+}
+
+var apiFieldListIndexRe = regexp.MustCompile(`^(.+)\[(\d+)]$`)
+
+// apiFieldAttributePath maps a field path of the meshStack API payload, such as spec.inputs.foo or
+// spec.tags[0], to the attribute path in data, such as spec.inputs["foo"]: the camelCase field names
+// become the snake_case attribute names, and a name without matching attribute is tried as map key.
+// Resolving stops at the first field without match, the path resolved so far is returned then.
+// ok is false if not even the first field matches an attribute.
+func apiFieldAttributePath(ctx context.Context, data pathMatcher, field string) (result path.Path, ok bool) {
+	matches := func(candidate path.Path) bool {
+		paths, diags := data.PathMatches(ctx, candidate.Expression())
+		return !diags.HasError() && len(paths) == 1 && paths[0].Equal(candidate)
+	}
+	resolve := func(name string) bool {
+		var candidates []path.Path
+		if len(result.Steps()) == 0 {
+			candidates = []path.Path{path.Root(camelToSnakeCase(name))}
+		} else {
+			candidates = []path.Path{result.AtName(camelToSnakeCase(name)), result.AtMapKey(name)}
+		}
+		for _, candidate := range candidates {
+			if matches(candidate) {
+				result = candidate
+				return true
+			}
+		}
+		return false
+	}
+	for segment := range strings.SplitSeq(field, ".") {
+		name, index := segment, -1
+		if match := apiFieldListIndexRe.FindStringSubmatch(segment); match != nil {
+			name = match[1]
+			index, _ = strconv.Atoi(match[2])
+		}
+		if !resolve(name) {
+			break
+		}
+		ok = true
+		if index >= 0 {
+			if candidate := result.AtListIndex(index); matches(candidate) {
+				result = candidate
+			} else {
+				break
+			}
+		}
+	}
+	return
+}
+
+// camelToSnakeCase converts e.g. displayName to display_name.
+func camelToSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
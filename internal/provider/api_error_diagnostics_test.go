@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+func TestAddApiErrorDiagnostics(t *testing.T) {
+	ctx := context.Background()
+	plan := tfsdk.Plan{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+			"spec": schema.SingleNestedAttribute{Attributes: map[string]schema.Attribute{
+				"display_name": schema.StringAttribute{},
+				"inputs":       schema.MapAttribute{ElementType: types.StringType},
+				"tags":         schema.ListAttribute{ElementType: types.StringType},
+			}},
+		}},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"spec": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"display_name": tftypes.String,
+				"inputs":       tftypes.Map{ElementType: tftypes.String},
+				"tags":         tftypes.List{ElementType: tftypes.String},
+			}},
+		}}, map[string]tftypes.Value{
+			"spec": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"display_name": tftypes.String,
+				"inputs":       tftypes.Map{ElementType: tftypes.String},
+				"tags":         tftypes.List{ElementType: tftypes.String},
+			}}, map[string]tftypes.Value{
+				"display_name": tftypes.NewValue(tftypes.String, "name"),
+				"inputs": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"size": tftypes.NewValue(tftypes.String, "XXL"),
+				}),
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+			}),
+		}),
+	}
+
+	t.Run("attribute paths", func(t *testing.T) {
+		tests := map[string]struct {
+			field  string
+			want   path.Path
+			wantOk bool
+		}{
+			"camel case name": {"spec.displayName", path.Root("spec").AtName("display_name"), true},
+			"map key":         {"spec.inputs.size", path.Root("spec").AtName("inputs").AtMapKey("size"), true},
+			"list index":      {"spec.tags[0]", path.Root("spec").AtName("tags").AtListIndex(0), true},
+			"unknown leaf":    {"spec.unknown", path.Root("spec"), true},
+			"unknown root":    {"metadata.name", path.Empty(), false},
+		}
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				got, ok := apiFieldAttributePath(ctx, plan, test.field)
+				assert.Equal(t, test.wantOk, ok)
+				if test.wantOk {
+					assert.True(t, got.Equal(test.want), "got %s", got)
+				}
+			})
+		}
+	})
+
+	t.Run("violations become attribute errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addApiErrorDiagnostics(ctx, &diags, plan, "Error creating", client.HttpError{
+			StatusCode: http.StatusBadRequest,
+			Message:    "invalid workspace",
+			ErrorCode:  "VALIDATION_ERROR",
+			Violations: []client.FieldViolation{
+				{Field: "spec.displayName", Message: "must not be blank"},
+				{Field: "metadata.name", Message: "already taken"},
+			},
+		})
+		require.Len(t, diags, 3)
+		assert.Equal(t, "http error 400 (VALIDATION_ERROR): invalid workspace", diags[0].Detail())
+		attributeDiag, ok := diags[1].(diag.DiagnosticWithPath)
+		require.True(t, ok)
+		assert.True(t, attributeDiag.Path().Equal(path.Root("spec").AtName("display_name")))
+		assert.Equal(t, "spec.displayName: must not be blank", diags[1].Detail())
+		_, ok = diags[2].(diag.DiagnosticWithPath)
+		assert.False(t, ok)
+		assert.Equal(t, "metadata.name: already taken", diags[2].Detail())
+	})
+
+	t.Run("errors without violations", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addApiErrorDiagnostics(ctx, &diags, plan, "Error creating", client.HttpError{StatusCode: http.StatusInternalServerError, ResponseBody: []byte("boom")})
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Error creating", diags[0].Summary())
+	})
+}
@@ -23,6 +23,7 @@ var (
 	_ resource.Resource               = &apiKeyResource{}
 	_ resource.ResourceWithConfigure  = &apiKeyResource{}
 	_ resource.ResourceWithModifyPlan = &apiKeyResource{}
+	_ resource.ResourceWithIdentity   = &apiKeyResource{}
 )
 
 func NewApiKeyResource() resource.Resource {
@@ -142,6 +143,10 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 }
 
+func (r *apiKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("API key")
+}
+
 func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	plan := generic.Get[*client.MeshApiKey](ctx, req.Plan, &resp.Diagnostics,
 		generic.WithSliceTypeAsSet(clientTypes.IsSet),
@@ -153,11 +158,12 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	created, err := r.meshApiKeyClient.Create(ctx, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to create API key", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Unable to create API key", err)
 		return
 	}
 
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, created, generic.WithSliceTypeAsSet(clientTypes.IsSet))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *created.Metadata.Uuid})...)
 }
 
 func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -187,6 +193,7 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, apiKey, generic.WithSliceTypeAsSet(clientTypes.IsSet))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: uuid})...)
 }
 
 func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -200,7 +207,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	updated, err := r.meshApiKeyClient.Update(ctx, *plan.Metadata.Uuid, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update API key", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Unable to update API key", err)
 		return
 	}
 
@@ -214,6 +221,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, updated, generic.WithSliceTypeAsSet(clientTypes.IsSet))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *updated.Metadata.Uuid})...)
 }
 
 func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
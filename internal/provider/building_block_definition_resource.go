@@ -23,6 +23,7 @@ var (
 	_ resource.ResourceWithImportState    = &buildingBlockDefinitionResource{}
 	_ resource.ResourceWithModifyPlan     = &buildingBlockDefinitionResource{}
 	_ resource.ResourceWithValidateConfig = &buildingBlockDefinitionResource{}
+	_ resource.ResourceWithIdentity       = &buildingBlockDefinitionResource{}
 )
 
 func NewBuildingBlockDefinitionResource() resource.Resource {
@@ -32,6 +33,7 @@ func NewBuildingBlockDefinitionResource() resource.Resource {
 type buildingBlockDefinitionResource struct {
 	buildingBlockDefinitionClient        client.MeshBuildingBlockDefinitionClient
 	buildingBlockDefinitionVersionClient client.MeshBuildingBlockDefinitionVersionClient
+	meshInfoClient                       client.MeshInfoClient
 }
 
 func (r *buildingBlockDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,9 +44,14 @@ func (r *buildingBlockDefinitionResource) Configure(_ context.Context, req resou
 	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
 		r.buildingBlockDefinitionClient = client.BuildingBlockDefinition
 		r.buildingBlockDefinitionVersionClient = client.BuildingBlockDefinitionVersion
+		r.meshInfoClient = client.MeshInfo
 	})...)
 }
 
+func (r *buildingBlockDefinitionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("building block definition")
+}
+
 func (r *buildingBlockDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	converterOptions := buildingBlockDefinitionConverterOptions().
 		Append(buildingBlockDefinitionVersionConverterOptions(ctx, req.Config, req.Plan, nil)...)
@@ -57,7 +64,7 @@ func (r *buildingBlockDefinitionResource) Create(ctx context.Context, req resour
 		Spec:     plan.Spec,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating MeshBuildingBlockDefinition", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating MeshBuildingBlockDefinition", err)
 		return
 	}
 
@@ -99,11 +106,11 @@ func (r *buildingBlockDefinitionResource) Create(ctx context.Context, req resour
 	}
 	createdVersionDto, err := r.buildingBlockDefinitionVersionClient.Update(ctx, versionUuid, createdDto.Metadata.OwnedByWorkspace, createVersionSpecDto)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating initial version", fmt.Sprintf(
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating initial version", fmt.Errorf(
 			"Building block '%s', uuid=%s was just created, and the initial version '%s' failed to update with given version_spec configuration. "+
 				"Most likely schema validation is insufficient and the API received an invalid or incomplete JSON payload.\n"+
-				"Error: %s",
-			createdDto.Spec.DisplayName, bbdUuid, versionUuid, err.Error(),
+				"Error: %w",
+			createdDto.Spec.DisplayName, bbdUuid, versionUuid, err,
 		))
 		return
 	}
@@ -113,6 +120,7 @@ func (r *buildingBlockDefinitionResource) Create(ctx context.Context, req resour
 		return
 	}
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: bbdUuid})...)
 }
 
 func (r *buildingBlockDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -163,6 +171,7 @@ func (r *buildingBlockDefinitionResource) Read(ctx context.Context, req resource
 	}
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, state,
 		buildingBlockDefinitionConverterOptions().Append(buildingBlockDefinitionVersionConverterOptions(ctx, nil, nil, req.State)...)...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: bbdUuid})...)
 }
 
 // outputStringAttr safely extracts a string attribute from a nested output object's attribute map,
@@ -358,6 +367,11 @@ func (r *buildingBlockDefinitionResource) ModifyPlan(ctx context.Context, req re
 		return
 	}
 
+	checkFeatureGates(ctx, r.meshInfoClient, "meshstack_building_block_definition", req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	versionSpecSecretsChanged := false
 	secret.WalkSecretPathsIn(req.Plan.Raw, &resp.Diagnostics, func(attributePath path.Path, diags *diag.Diagnostics) {
 		versionChanged := secret.SetToUnknownIfVersionChangedOrCreated(ctx, req.Plan, req.State, &resp.Plan)(attributePath, diags)
@@ -578,7 +592,7 @@ func (r *buildingBlockDefinitionResource) Update(ctx context.Context, req resour
 		Spec:     plan.Spec,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating MeshBuildingBlockDefinition", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating MeshBuildingBlockDefinition", err)
 		return
 	}
 
@@ -606,9 +620,9 @@ func (r *buildingBlockDefinitionResource) Update(ctx context.Context, req resour
 		versionSpecDto.VersionNumber = new(state.VersionLatest.Number.Get() + 1)
 		updatedVersionDto, err = r.buildingBlockDefinitionVersionClient.Create(ctx, plan.Metadata.OwnedByWorkspace, versionSpecDto)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating new version", fmt.Sprintf(
-				"Failed to create new version for building block '%s', ID=%s:\n%s",
-				updatedDto.Spec.DisplayName, bbdUuid, err.Error(),
+			addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating new version", fmt.Errorf(
+				"Failed to create new version for building block '%s', ID=%s:\n%w",
+				updatedDto.Spec.DisplayName, bbdUuid, err,
 			))
 			return
 		}
@@ -657,9 +671,9 @@ func (r *buildingBlockDefinitionResource) Update(ctx context.Context, req resour
 		latestVersionUuid := *state.VersionLatest.Uuid.Value
 		updatedVersionDto, err = r.buildingBlockDefinitionVersionClient.Update(ctx, latestVersionUuid, plan.Metadata.OwnedByWorkspace, versionSpecDto)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating version", fmt.Sprintf(
-				"Failed to update version '%s' for building block '%s', ID=%s:\n%s",
-				latestVersionUuid, updatedDto.Spec.DisplayName, bbdUuid, err.Error(),
+			addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating version", fmt.Errorf(
+				"Failed to update version '%s' for building block '%s', ID=%s:\n%w",
+				latestVersionUuid, updatedDto.Spec.DisplayName, bbdUuid, err,
 			))
 			return
 		}
@@ -693,6 +707,7 @@ func (r *buildingBlockDefinitionResource) Update(ctx context.Context, req resour
 
 	// Finally, the plan is aligned with the backend, and we can set it as the new state!
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: bbdUuid})...)
 }
 
 func (r *buildingBlockDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -710,5 +725,5 @@ func (r *buildingBlockDefinitionResource) Delete(ctx context.Context, req resour
 }
 
 func (r *buildingBlockDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("uuid"), path.Root("uuid"), req, resp)
 }
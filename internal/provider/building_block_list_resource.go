@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/types/generic"
+)
+
+var (
+	_ list.ListResource              = &buildingBlockListResource{}
+	_ list.ListResourceWithConfigure = &buildingBlockListResource{}
+)
+
+func NewBuildingBlockListResource() list.ListResource {
+	return &buildingBlockListResource{}
+}
+
+type buildingBlockListResource struct {
+	client client.MeshBuildingBlockV2Client
+}
+
+// buildingBlockListResourceModel holds the optional filters, which are omitted from the backend query when unset.
+type buildingBlockListResourceModel struct {
+	WorkspaceIdentifier          *string `tfsdk:"workspace_identifier"`
+	ProjectIdentifier            *string `tfsdk:"project_identifier"`
+	PlatformIdentifier           *string `tfsdk:"platform_identifier"`
+	Name                         *string `tfsdk:"name"`
+	DefinitionUuid               *string `tfsdk:"definition_uuid"`
+	VersionUuid                  *string `tfsdk:"version_uuid"`
+	VersionNumber                *string `tfsdk:"version_number"`
+	TenantUuid                   *string `tfsdk:"tenant_uuid"`
+	TargetKind                   *string `tfsdk:"target_kind"`
+	Status                       *string `tfsdk:"status"`
+	ManagedByWorkspaceIdentifier *string `tfsdk:"managed_by_workspace_identifier"`
+	ManagedByDefinitionUuid      *string `tfsdk:"managed_by_definition_uuid"`
+}
+
+func (r *buildingBlockListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_building_block"
+}
+
+func (r *buildingBlockListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.client = client.BuildingBlockV2
+	})...)
+}
+
+func (r *buildingBlockListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	optionalString := func(md string) schema.StringAttribute {
+		return schema.StringAttribute{MarkdownDescription: md, Optional: true}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists building blocks with optional filters. Deleted building blocks are skipped." + previewDisclaimer(),
+		Attributes: map[string]schema.Attribute{
+			"workspace_identifier": optionalString("Only return building blocks owned by or assigned to this workspace."),
+			"project_identifier":   optionalString("Only return building blocks in this project."),
+			"platform_identifier":  optionalString("Only return building blocks on this platform (`<platformInstance>.<location>`)."),
+			"name":                 optionalString("Only return building blocks with this exact name."),
+			"definition_uuid":      optionalString("Only return building blocks created from the building block definition with this UUID (the definition, not a specific version)."),
+			"version_uuid":         optionalString("Only return building blocks created from the building block definition version with this UUID."),
+			"version_number": optionalString("Only return building blocks created from this building block definition version number. " +
+				"Accepts a plain number (`1`) or a `v`-prefixed string (`v1`); the `v` is stripped server-side."),
+			"tenant_uuid": optionalString("Only return building blocks targeting the tenant with this UUID."),
+			"target_kind": schema.StringAttribute{
+				MarkdownDescription: "Only return building blocks with this target kind. One of `meshTenant`, `meshWorkspace`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.MeshObjectKind.Tenant, client.MeshObjectKind.Workspace),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return building blocks in this execution status. One of " + client.BuildingBlockStatuses.Markdown() + ".",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.BuildingBlockStatuses.Strings()...),
+				},
+			},
+			"managed_by_workspace_identifier": optionalString("Platform-operator scope: return building blocks created from definitions owned by this workspace. " +
+				"Requires the `MANAGED_BUILDINGBLOCK_LIST` authority."),
+			"managed_by_definition_uuid": optionalString("Platform-operator scope: return building blocks created from the definition owned by the caller with this UUID. " +
+				"Requires the `MANAGED_BUILDINGBLOCK_LIST` authority."),
+		},
+	}
+}
+
+func (r *buildingBlockListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config buildingBlockListResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	blocks := r.client.All(ctx, client.MeshBuildingBlockV2ListFilter{
+		WorkspaceIdentifier:          config.WorkspaceIdentifier,
+		ProjectIdentifier:            config.ProjectIdentifier,
+		PlatformIdentifier:           config.PlatformIdentifier,
+		Name:                         config.Name,
+		DefinitionUuid:               config.DefinitionUuid,
+		VersionUuid:                  config.VersionUuid,
+		VersionNumber:                config.VersionNumber,
+		TenantUuid:                   config.TenantUuid,
+		TargetKind:                   config.TargetKind,
+		Status:                       config.Status,
+		ManagedByWorkspaceIdentifier: config.ManagedByWorkspaceIdentifier,
+		ManagedByDefinitionUuid:      config.ManagedByDefinitionUuid,
+	})
+	// Soft-deleted building blocks are still listed, but the resource would drop them on the next refresh.
+	notDeleted := func(yield func(client.MeshBuildingBlockV2, error) bool) {
+		for bb, err := range blocks {
+			if err == nil && bb.Status != nil && bb.Status.Lifecycle.State == client.BuildingBlockLifecycleStateDeleted {
+				continue
+			}
+			if !yield(bb, err) {
+				return
+			}
+		}
+	}
+	stream.Results = listResults(ctx, req, notDeleted, "Unable to list building blocks", func(bb client.MeshBuildingBlockV2, result *list.ListResult) {
+		result.DisplayName = bb.Spec.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, uuidIdentity{Uuid: *bb.Metadata.Uuid})...)
+		if req.IncludeResource {
+			// Mirror an import: set user inputs are kept in spec.inputs and wait_for_completion takes its default.
+			var model buildingBlockModel
+			model.SetFromClientDto(&bb, true, &result.Diagnostics)
+			model.WaitForCompletion = true
+			result.Diagnostics.Append(generic.Set(ctx, result.Resource, model, buildingBlockConverterOptions(ctx, nil, nil, nil)...)...)
+		}
+	})
+}
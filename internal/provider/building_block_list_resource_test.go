@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+func TestBuildingBlockListResource(t *testing.T) {
+	ctx := context.Background()
+	mockClient := clientmock.NewMock()
+	uuids := map[string]string{}
+	for _, name := range []string{"bb-a", "bb-deleted", "bb-b"} {
+		bb, err := mockClient.BuildingBlockV2.Create(ctx, &client.MeshBuildingBlockV2{
+			Spec: client.MeshBuildingBlockV2Spec{
+				DisplayName:                       name,
+				BuildingBlockDefinitionVersionRef: client.MeshBuildingBlockV2DefinitionVersionRef{UuidRef: client.UuidRef{Uuid: "version-uuid", Kind: client.MeshObjectKind.BuildingBlockDefinitionVersion}},
+				TargetRef:                         client.MeshBuildingBlockV2TargetRef{Kind: client.MeshObjectKind.Workspace, Name: new("my-workspace")},
+			},
+		})
+		require.NoError(t, err)
+		uuids[name] = *bb.Metadata.Uuid
+	}
+	deleted, _ := mockClient.BuildingBlockV2.Store.Get(uuids["bb-deleted"])
+	deletedCopy := *deleted
+	deletedCopy.Status = &client.MeshBuildingBlockV2Status{Lifecycle: client.MeshBuildingBlockV2Lifecycle{State: client.BuildingBlockLifecycleStateDeleted}}
+	require.NoError(t, mockClient.BuildingBlockV2.Store.Set(uuids["bb-deleted"], &deletedCopy))
+	buildingBlockClient := &recordingBuildingBlockClient{MeshBuildingBlockV2Client: mockClient.BuildingBlockV2}
+	listResource := &buildingBlockListResource{client: buildingBlockClient}
+
+	testListResource(t, NewBuildingBlockResource(), listResource, &buildingBlockClient.query, func(t *testing.T, result list.ListResult) {
+		var identity uuidIdentity
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		assert.Equal(t, uuids[result.DisplayName], identity.Uuid)
+		assert.Equal(t, identity.Uuid, listedAttribute(t, result, path.Root("metadata").AtName("uuid")))
+		assert.Equal(t, result.DisplayName, listedAttribute(t, result, path.Root("spec").AtName("display_name")))
+	}, listResourceCase[client.MeshBuildingBlockV2ListFilter]{
+		name: "filters",
+		config: map[string]tftypes.Value{
+			"workspace_identifier":            tftypes.NewValue(tftypes.String, "my-workspace"),
+			"project_identifier":              tftypes.NewValue(tftypes.String, "my-project"),
+			"platform_identifier":             tftypes.NewValue(tftypes.String, "aws.eu"),
+			"name":                            tftypes.NewValue(tftypes.String, "my-building-block"),
+			"definition_uuid":                 tftypes.NewValue(tftypes.String, "definition-uuid"),
+			"version_uuid":                    tftypes.NewValue(tftypes.String, "version-uuid"),
+			"version_number":                  tftypes.NewValue(tftypes.String, "v1"),
+			"tenant_uuid":                     tftypes.NewValue(tftypes.String, "tenant-uuid"),
+			"target_kind":                     tftypes.NewValue(tftypes.String, client.MeshObjectKind.Tenant),
+			"status":                          tftypes.NewValue(tftypes.String, "SUCCEEDED"),
+			"managed_by_workspace_identifier": tftypes.NewValue(tftypes.String, "operator-workspace"),
+			"managed_by_definition_uuid":      tftypes.NewValue(tftypes.String, "managed-definition-uuid"),
+		},
+		wantQuery: client.MeshBuildingBlockV2ListFilter{
+			WorkspaceIdentifier:          new("my-workspace"),
+			ProjectIdentifier:            new("my-project"),
+			PlatformIdentifier:           new("aws.eu"),
+			Name:                         new("my-building-block"),
+			DefinitionUuid:               new("definition-uuid"),
+			VersionUuid:                  new("version-uuid"),
+			VersionNumber:                new("v1"),
+			TenantUuid:                   new("tenant-uuid"),
+			TargetKind:                   new(client.MeshObjectKind.Tenant),
+			Status:                       new("SUCCEEDED"),
+			ManagedByWorkspaceIdentifier: new("operator-workspace"),
+			ManagedByDefinitionUuid:      new("managed-definition-uuid"),
+		},
+	}, listResourceCase[client.MeshBuildingBlockV2ListFilter]{
+		name:             "skips deleted building blocks",
+		wantDisplayNames: []string{"bb-a", "bb-b"},
+	}, listResourceCase[client.MeshBuildingBlockV2ListFilter]{
+		name:             "include resource",
+		includeResource:  true,
+		wantDisplayNames: []string{"bb-a", "bb-b"},
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		results := listAllForTest(t, listResource, newListRequest(t, NewBuildingBlockResource(), listResource, nil, 1, false))
+		assert.Len(t, results, 1)
+	})
+}
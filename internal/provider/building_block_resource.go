@@ -44,6 +44,7 @@ var (
 	_ resource.ResourceWithModifyPlan   = &buildingBlockResource{}
 	_ resource.ResourceWithMoveState    = &buildingBlockResource{}
 	_ resource.ResourceWithUpgradeState = &buildingBlockResource{}
+	_ resource.ResourceWithIdentity     = &buildingBlockResource{}
 )
 
 // defaultBuildingBlockTimeout is the fallback time to wait for a building block run to complete when
@@ -57,6 +58,7 @@ func NewBuildingBlockResource() resource.Resource {
 type buildingBlockResource struct {
 	BuildingBlockClient    client.MeshBuildingBlockV2Client
 	BuildingBlockRunClient client.MeshBuildingBlockRunClient
+	meshInfoClient         client.MeshInfoClient
 }
 
 func (r *buildingBlockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,6 +69,7 @@ func (r *buildingBlockResource) Configure(_ context.Context, req resource.Config
 	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
 		r.BuildingBlockClient = client.BuildingBlockV2
 		r.BuildingBlockRunClient = client.BuildingBlockRun
+		r.meshInfoClient = client.MeshInfo
 	})...)
 }
 
@@ -663,10 +666,16 @@ func addWaitingForInputWarning(diags *diag.Diagnostics, bb *client.MeshBuildingB
 // SUCCEEDED/FAILED/ABORTED are terminal, and a WAITING_FOR_*_INPUT status means the block is parked and
 // cannot proceed from this apply (a runnable block would be PENDING) — surfaced as a non-fatal warning
 // rather than polling to the timeout.
+//
+// A run started with TriggerRun is not eager-set: meshStack starts it asynchronously, so the block keeps
+// reporting the previous run's status for a while. Pass that run's uuid as previousRunUuid to keep polling
+// until a different run shows up. A nil previousRunUuid (run uuids hidden by run transparency) cannot
+// disambiguate and waits on the status alone.
 func (r *buildingBlockResource) awaitRun(
 	ctx context.Context,
 	diags *diag.Diagnostics,
 	uuid string,
+	previousRunUuid *string,
 	waitForCompletion bool,
 	timeout time.Duration,
 ) *client.MeshBuildingBlockV2 {
@@ -683,6 +692,10 @@ func (r *buildingBlockResource) awaitRun(
 			// Parked waiting for input this apply cannot supply — terminal-but-non-fatal (warned below).
 			return true, nil
 		}
+		if previousRunUuid != nil && bb.Status != nil && bb.Status.LatestRunUuid != nil && *bb.Status.LatestRunUuid == *previousRunUuid {
+			// The triggered run has not started yet; the status still belongs to the previous run.
+			return false, nil
+		}
 		return bb.CreateSuccessful()
 	}
 	var final *client.MeshBuildingBlockV2
@@ -697,6 +710,10 @@ func (r *buildingBlockResource) awaitRun(
 	return final
 }
 
+func (r *buildingBlockResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("building block")
+}
+
 func (r *buildingBlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	converterOptions := buildingBlockConverterOptions(ctx, req.Config, req.Plan, nil)
 
@@ -708,11 +725,12 @@ func (r *buildingBlockResource) Create(ctx context.Context, req resource.CreateR
 	// Send only Spec — Metadata.Uuid and Status are assigned by the backend.
 	created, err := r.BuildingBlockClient.Create(ctx, &client.MeshBuildingBlockV2{Spec: plan.Spec})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating building block", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating building block", err)
 		return
 	}
 	plan.SetFromClientDto(created, false, &resp.Diagnostics)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *created.Metadata.Uuid})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -732,7 +750,7 @@ func (r *buildingBlockResource) Create(ctx context.Context, req resource.CreateR
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		final := r.awaitRun(ctx, &resp.Diagnostics, *created.Metadata.Uuid, true, timeout)
+		final := r.awaitRun(ctx, &resp.Diagnostics, *created.Metadata.Uuid, nil, true, timeout)
 		if final != nil {
 			plan.SetFromClientDto(final, false, &resp.Diagnostics)
 			resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, converterOptions...)...)
@@ -778,6 +796,7 @@ func (r *buildingBlockResource) Read(ctx context.Context, req resource.ReadReque
 	state.WaitForCompletion = waitForCompletionBool
 	state.PurgeOnDelete = purgeOnDelete
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, state, converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *readDto.Metadata.Uuid})...)
 }
 
 // requiresReplaceParentsWhenVersionUnchanged forces replacement when parent_building_block_refs changes
@@ -875,6 +894,11 @@ func (r *buildingBlockResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return // destroy — nothing to modify
 	}
 
+	checkFeatureGates(ctx, r.meshInfoClient, "meshstack_building_block", req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	secret.WalkSecretPathsIn(req.Plan.Raw, &resp.Diagnostics, func(attributePath path.Path, diags *diag.Diagnostics) {
 		secret.SetToUnknownIfVersionChangedOrCreated(ctx, req.Plan, req.State, &resp.Plan)(attributePath, diags)
 	})
@@ -1024,7 +1048,7 @@ func (r *buildingBlockResource) Update(ctx context.Context, req resource.UpdateR
 			)
 			return
 		}
-		resp.Diagnostics.AddError("Error updating building block", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating building block", err)
 		return
 	}
 
@@ -1036,7 +1060,12 @@ func (r *buildingBlockResource) Update(ctx context.Context, req resource.UpdateR
 		planInputsChanged(plan.Spec.Inputs, state.Spec.Inputs) ||
 		!reflect.DeepEqual(plan.Spec.ParentBuildingBlockRefs, state.Spec.ParentBuildingBlockRefs) ||
 		secretRotated
+	var previousRunUuid *string
 	if needsRun && !backendWillRun {
+		// Unlike the PUT, the trigger-run is not eager-set to PENDING, see awaitRun.
+		if updated.Status != nil {
+			previousRunUuid = updated.Status.LatestRunUuid
+		}
 		if err := r.BuildingBlockClient.TriggerRun(ctx, *updated.Metadata.Uuid); err != nil {
 			resp.Diagnostics.AddError("Error triggering building block run", err.Error())
 			return
@@ -1050,7 +1079,7 @@ func (r *buildingBlockResource) Update(ctx context.Context, req resource.UpdateR
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		final := r.awaitRun(ctx, &resp.Diagnostics, *updated.Metadata.Uuid, plan.WaitForCompletion, timeout)
+		final := r.awaitRun(ctx, &resp.Diagnostics, *updated.Metadata.Uuid, previousRunUuid, plan.WaitForCompletion, timeout)
 		if final != nil {
 			effective = final
 		}
@@ -1060,6 +1089,7 @@ func (r *buildingBlockResource) Update(ctx context.Context, req resource.UpdateR
 	// Reuse the converter options built at the top of Update (same config/plan/state getters), so
 	// secret_version resolves consistently with Read without rebuilding the converters.
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *effective.Metadata.Uuid})...)
 }
 
 func (r *buildingBlockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1096,7 +1126,7 @@ func (r *buildingBlockResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *buildingBlockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("uuid"), path.Root("uuid"), req, resp)
 	// content_hash is json:"-" and never returned by the API, so import leaves it null.
 	// With the rerun semantics (state nil + plan non-nil = "newly set" → rerun), this is by design:
 	// the first apply after import triggers a run if content_hash is set in config.
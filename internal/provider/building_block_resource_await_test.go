@@ -75,7 +75,7 @@ func TestAwaitRunPollsPendingToSuccess(t *testing.T) {
 	r := &buildingBlockResource{BuildingBlockClient: stub}
 
 	var diags diag.Diagnostics
-	final := r.awaitRun(context.Background(), &diags, "bb-uuid", true, 30*time.Second)
+	final := r.awaitRun(context.Background(), &diags, "bb-uuid", nil, true, 30*time.Second)
 
 	require.False(t, diags.HasError(), "unexpected error diagnostics: %v", diags.Errors())
 	require.Empty(t, diags.Warnings(), "a completed run must not surface a waiting-for-input warning")
@@ -96,7 +96,7 @@ func TestAwaitRunAwaitsWithoutRunUuid(t *testing.T) {
 	r := &buildingBlockResource{BuildingBlockClient: stub}
 
 	var diags diag.Diagnostics
-	final := r.awaitRun(context.Background(), &diags, "bb-uuid", true, 30*time.Second)
+	final := r.awaitRun(context.Background(), &diags, "bb-uuid", nil, true, 30*time.Second)
 
 	require.False(t, diags.HasError(), "unexpected error diagnostics: %v", diags.Errors())
 	require.Empty(t, diags.Warnings())
@@ -118,7 +118,7 @@ func TestAwaitRunErrorsWhenBlockDisappears(t *testing.T) {
 	r := &buildingBlockResource{BuildingBlockClient: stub}
 
 	var diags diag.Diagnostics
-	final := r.awaitRun(context.Background(), &diags, "bb-uuid", true, 30*time.Second)
+	final := r.awaitRun(context.Background(), &diags, "bb-uuid", nil, true, 30*time.Second)
 
 	require.True(t, diags.HasError(), "a block disappearing mid-run must surface an error diagnostic, not panic")
 	require.Nil(t, final)
@@ -140,7 +140,7 @@ func TestAwaitRunSurfacesFailedStepLogAsError(t *testing.T) {
 	r := &buildingBlockResource{BuildingBlockClient: stub, BuildingBlockRunClient: runClient}
 
 	var diags diag.Diagnostics
-	final := r.awaitRun(context.Background(), &diags, "bb-uuid", true, 30*time.Second)
+	final := r.awaitRun(context.Background(), &diags, "bb-uuid", nil, true, 30*time.Second)
 
 	require.True(t, diags.HasError(), "a failed run must surface error diagnostics")
 	require.Empty(t, diags.Warnings(), "readable failed-step logs must be errors, not warnings")
@@ -168,7 +168,7 @@ func TestAwaitRunWarnsWhenFailedRunLogsUnreadable(t *testing.T) {
 	r := &buildingBlockResource{BuildingBlockClient: stub}
 
 	var diags diag.Diagnostics
-	final := r.awaitRun(context.Background(), &diags, "bb-uuid", true, 30*time.Second)
+	final := r.awaitRun(context.Background(), &diags, "bb-uuid", nil, true, 30*time.Second)
 
 	require.True(t, diags.HasError(), "a failed run must surface an error diagnostic")
 	require.NotNil(t, final)
@@ -187,7 +187,7 @@ func TestAwaitRunWarnsOnParkedWaiting(t *testing.T) {
 	r := &buildingBlockResource{BuildingBlockClient: stub}
 
 	var diags diag.Diagnostics
-	final := r.awaitRun(context.Background(), &diags, "bb-uuid", true, 30*time.Second)
+	final := r.awaitRun(context.Background(), &diags, "bb-uuid", nil, true, 30*time.Second)
 
 	require.False(t, diags.HasError(), "unexpected error diagnostics: %v", diags.Errors())
 	require.NotEmpty(t, diags.Warnings(), "a parked WAITING block must surface a waiting-for-input warning")
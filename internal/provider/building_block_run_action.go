@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ action.Action              = &buildingBlockRunAction{}
+	_ action.ActionWithConfigure = &buildingBlockRunAction{}
+)
+
+func NewBuildingBlockRunAction() action.Action {
+	return &buildingBlockRunAction{}
+}
+
+type buildingBlockRunAction struct {
+	// buildingBlock provides the run polling and failure reporting of the building block resource,
+	// so a run triggered by this action is awaited and reported exactly like one triggered by an apply.
+	buildingBlock buildingBlockResource
+}
+
+type buildingBlockRunActionModel struct {
+	BuildingBlockUuid string  `tfsdk:"building_block_uuid"`
+	WaitForCompletion *bool   `tfsdk:"wait_for_completion"`
+	Timeout           *string `tfsdk:"timeout"`
+}
+
+func (a *buildingBlockRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_building_block_run"
+}
+
+func (a *buildingBlockRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		a.buildingBlock.BuildingBlockClient = client.BuildingBlockV2
+		a.buildingBlock.BuildingBlockRunClient = client.BuildingBlockRun
+	})...)
+}
+
+func (a *buildingBlockRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers a new run of an existing building block, e.g. to rerun it after fixing something outside Terraform. " +
+			"Invoke it with `terraform apply -invoke=action.meshstack_building_block_run.<name>` or from a resource's `lifecycle.action_trigger`. " +
+			"Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"building_block_uuid": schema.StringAttribute{
+				MarkdownDescription: "UUID of the building block to run, e.g. `meshstack_building_block.example.metadata.uuid`.",
+				Required:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the run to reach a terminal state (SUCCEEDED or FAILED). Defaults to `true`. " +
+					"A failed run fails the invocation with the first failed step of its logs, and a run blocked in `WAITING_FOR_OPERATOR_INPUT` emits a warning, like for the `meshstack_building_block` resource.",
+				Optional: true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait for the run to complete, as a duration such as `30m` or `1h`. Defaults to `%s`.", defaultBuildingBlockTimeout),
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *buildingBlockRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config buildingBlockRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultBuildingBlockTimeout
+	if config.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", err.Error())
+			return
+		}
+	}
+
+	waitForCompletion := config.WaitForCompletion == nil || *config.WaitForCompletion
+	var previousRunUuid *string
+	if waitForCompletion {
+		// Record the run before triggering: the new run starts asynchronously, so the first polls may still
+		// report the previous run's (terminal) status.
+		previous, err := a.buildingBlock.BuildingBlockClient.Read(client.WithoutReadCache(ctx), config.BuildingBlockUuid)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading building block", err.Error())
+			return
+		} else if previous == nil {
+			resp.Diagnostics.AddError("Building block not found", fmt.Sprintf("Building block %s does not exist.", config.BuildingBlockUuid))
+			return
+		}
+		if previous.Status != nil {
+			previousRunUuid = previous.Status.LatestRunUuid
+		}
+	}
+
+	if err := a.buildingBlock.BuildingBlockClient.TriggerRun(ctx, config.BuildingBlockUuid); err != nil {
+		resp.Diagnostics.AddError("Error triggering building block run", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Triggered a run of building block %s", config.BuildingBlockUuid)})
+
+	final := a.buildingBlock.awaitRun(ctx, &resp.Diagnostics, config.BuildingBlockUuid, previousRunUuid, waitForCompletion, timeout)
+	if final != nil && final.Status != nil && !resp.Diagnostics.HasError() {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Building block %s is in status %s", config.BuildingBlockUuid, final.Status.Status)})
+	}
+}
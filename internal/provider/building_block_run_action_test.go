@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// triggeringBBClient is a sequencedBBClient that records the building blocks it was asked to run.
+type triggeringBBClient struct {
+	*sequencedBBClient
+	triggered []string
+}
+
+func (c *triggeringBBClient) TriggerRun(_ context.Context, uuid string) error {
+	c.triggered = append(c.triggered, uuid)
+	return nil
+}
+
+// invokeBuildingBlockRunAction invokes the action with the given configuration values; attributes left
+// out of config are null. It returns the response and the progress messages sent.
+func invokeBuildingBlockRunAction(t *testing.T, a *buildingBlockRunAction, config map[string]tftypes.Value) (action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+
+	var progress []string
+	resp := action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		progress = append(progress, event.Message)
+	}}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}}, &resp)
+	return resp, progress
+}
+
+func TestBuildingBlockRunActionWaitsForRun(t *testing.T) {
+	t.Parallel()
+
+	stub := &triggeringBBClient{sequencedBBClient: &sequencedBBClient{states: []*client.MeshBuildingBlockV2{
+		bbWithRun(client.BuildingBlockStatusSucceeded, "run-old"),
+		bbWithRun(client.BuildingBlockStatusPending, "run-new"),
+		bbWithRun(client.BuildingBlockStatusSucceeded, "run-new"),
+	}}}
+	a := &buildingBlockRunAction{buildingBlock: buildingBlockResource{BuildingBlockClient: stub}}
+
+	resp, progress := invokeBuildingBlockRunAction(t, a, map[string]tftypes.Value{
+		"building_block_uuid": tftypes.NewValue(tftypes.String, "bb-uuid"),
+	})
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected error diagnostics: %v", resp.Diagnostics.Errors())
+	require.Equal(t, []string{"bb-uuid"}, stub.triggered)
+	require.GreaterOrEqual(t, stub.reads, 3, "must poll to the terminal state by default")
+	require.Equal(t, []string{
+		"Triggered a run of building block bb-uuid",
+		"Building block bb-uuid is in status SUCCEEDED",
+	}, progress)
+}
+
+// TestBuildingBlockRunActionWaitsForNewRun covers meshStack starting the triggered run asynchronously:
+// the block keeps reporting the previous run's SUCCEEDED for a few reads, which must not count as done.
+func TestBuildingBlockRunActionWaitsForNewRun(t *testing.T) {
+	t.Parallel()
+
+	stub := &triggeringBBClient{sequencedBBClient: &sequencedBBClient{states: []*client.MeshBuildingBlockV2{
+		bbWithRun(client.BuildingBlockStatusSucceeded, "run-old"),
+		bbWithRun(client.BuildingBlockStatusSucceeded, "run-old"),
+		bbWithRun(client.BuildingBlockStatusSucceeded, "run-old"),
+		bbWithRun(client.BuildingBlockStatusInProgress, "run-new"),
+		bbWithRun(client.BuildingBlockStatusFailed, "run-new"),
+	}}}
+	runClient := stubRunLogsClient{}
+	a := &buildingBlockRunAction{buildingBlock: buildingBlockResource{BuildingBlockClient: stub, BuildingBlockRunClient: runClient}}
+
+	resp, _ := invokeBuildingBlockRunAction(t, a, map[string]tftypes.Value{
+		"building_block_uuid": tftypes.NewValue(tftypes.String, "bb-uuid"),
+		"timeout":             tftypes.NewValue(tftypes.String, "30s"),
+	})
+
+	require.True(t, resp.Diagnostics.HasError(), "the new run failed, the previous run's SUCCEEDED must not be reported")
+	require.Equal(t, 5, stub.reads, "must poll past the previous run to the new run's terminal state")
+}
+
+func TestBuildingBlockRunActionReportsFailedRun(t *testing.T) {
+	t.Parallel()
+
+	stub := &triggeringBBClient{sequencedBBClient: &sequencedBBClient{states: []*client.MeshBuildingBlockV2{
+		bbWithRun(client.BuildingBlockStatusSucceeded, "run-old"),
+		bbWithRun(client.BuildingBlockStatusFailed, "run-new"),
+	}}}
+	runClient := stubRunLogsClient{logs: client.MeshBuildingBlockRunLogs{Steps: []client.MeshBuildingBlockRunStepLog{
+		{DisplayName: "apply", Status: string(client.BuildingBlockStatusFailed), UserMessage: new("intentionally broken")},
+	}}}
+	a := &buildingBlockRunAction{buildingBlock: buildingBlockResource{BuildingBlockClient: stub, BuildingBlockRunClient: runClient}}
+
+	resp, progress := invokeBuildingBlockRunAction(t, a, map[string]tftypes.Value{
+		"building_block_uuid": tftypes.NewValue(tftypes.String, "bb-uuid"),
+		"timeout":             tftypes.NewValue(tftypes.String, "30s"),
+	})
+
+	require.True(t, resp.Diagnostics.HasError())
+	summaries := make([]string, 0, len(resp.Diagnostics.Errors()))
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	require.Equal(t, []string{"Building block run failed", "Run step failed: apply"}, summaries)
+	require.Equal(t, []string{"Triggered a run of building block bb-uuid"}, progress)
+}
+
+func TestBuildingBlockRunActionWithoutWaiting(t *testing.T) {
+	t.Parallel()
+
+	stub := &triggeringBBClient{sequencedBBClient: &sequencedBBClient{}}
+	a := &buildingBlockRunAction{buildingBlock: buildingBlockResource{BuildingBlockClient: stub}}
+
+	resp, _ := invokeBuildingBlockRunAction(t, a, map[string]tftypes.Value{
+		"building_block_uuid": tftypes.NewValue(tftypes.String, "bb-uuid"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected error diagnostics: %v", resp.Diagnostics.Errors())
+	require.Equal(t, []string{"bb-uuid"}, stub.triggered)
+	require.Zero(t, stub.reads, "must not poll when wait_for_completion is false")
+}
+
+func TestBuildingBlockRunActionRejectsInvalidTimeout(t *testing.T) {
+	t.Parallel()
+
+	stub := &triggeringBBClient{sequencedBBClient: &sequencedBBClient{}}
+	a := &buildingBlockRunAction{buildingBlock: buildingBlockResource{BuildingBlockClient: stub}}
+
+	resp, _ := invokeBuildingBlockRunAction(t, a, map[string]tftypes.Value{
+		"building_block_uuid": tftypes.NewValue(tftypes.String, "bb-uuid"),
+		"timeout":             tftypes.NewValue(tftypes.String, "soon"),
+	})
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "Invalid timeout", resp.Diagnostics.Errors()[0].Summary())
+	require.Empty(t, stub.triggered, "must not trigger a run with an invalid timeout")
+}
@@ -24,6 +24,7 @@ var (
 	_ resource.ResourceWithConfigure      = &buildingBlockRunnerResource{}
 	_ resource.ResourceWithValidateConfig = &buildingBlockRunnerResource{}
 	_ resource.ResourceWithImportState    = &buildingBlockRunnerResource{}
+	_ resource.ResourceWithIdentity       = &buildingBlockRunnerResource{}
 )
 
 func NewBuildingBlockRunnerResource() resource.Resource {
@@ -234,6 +235,10 @@ func (r *buildingBlockRunnerResource) ValidateConfig(ctx context.Context, req re
 	)
 }
 
+func (r *buildingBlockRunnerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("building block runner")
+}
+
 func (r *buildingBlockRunnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	plan := generic.Get[buildingBlockRunnerModel](ctx, req.Plan, &resp.Diagnostics, generic.WithSetUnknownValueToZero())
 	if resp.Diagnostics.HasError() {
@@ -241,11 +246,12 @@ func (r *buildingBlockRunnerResource) Create(ctx context.Context, req resource.C
 	}
 	created, err := r.client.Create(ctx, plan.MeshBuildingBlockRunner)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating meshBuildingBlockRunner", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating meshBuildingBlockRunner", err)
 		return
 	}
 	plan.setFromClientDto(created)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *created.Metadata.Uuid})...)
 }
 
 func (r *buildingBlockRunnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -264,6 +270,7 @@ func (r *buildingBlockRunnerResource) Read(ctx context.Context, req resource.Rea
 	var state buildingBlockRunnerModel
 	state.setFromClientDto(runner)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: uuid})...)
 }
 
 func (r *buildingBlockRunnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,11 +280,12 @@ func (r *buildingBlockRunnerResource) Update(ctx context.Context, req resource.U
 	}
 	updated, err := r.client.Update(ctx, plan.MeshBuildingBlockRunner)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating meshBuildingBlockRunner", fmt.Sprintf("Updating runner '%s' failed: %s", *plan.Metadata.Uuid, err.Error()))
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating meshBuildingBlockRunner", fmt.Errorf("updating runner '%s' failed: %w", *plan.Metadata.Uuid, err))
 		return
 	}
 	plan.setFromClientDto(updated)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *updated.Metadata.Uuid})...)
 }
 
 func (r *buildingBlockRunnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -291,5 +299,5 @@ func (r *buildingBlockRunnerResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *buildingBlockRunnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("uuid"), path.Root("uuid"), req, resp)
 }
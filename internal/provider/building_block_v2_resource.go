@@ -371,10 +371,7 @@ func (r *buildingBlockV2Resource) Create(ctx context.Context, req resource.Creat
 
 	created, err := r.meshBuildingBlockV2Client.Create(ctx, &bb)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating building block",
-			"Could not create building block, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating building block", err)
 		return
 	}
 	resp.Diagnostics.Append(setStateFromResponseV2(ctx, &resp.State, created)...)
@@ -227,10 +227,7 @@ func (r *buildingblockResource) Create(ctx context.Context, req resource.CreateR
 
 	created, err := r.meshBuildingBlockClient.Create(ctx, &bb)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating building block",
-			"Could not create building block, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating building block", err)
 		return
 	}
 	resp.Diagnostics.Append(r.setStateFromResponse(&ctx, &resp.State, created)...)
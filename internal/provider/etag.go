@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// privateETagKey is the private state key keeping the ETag of the meshObject as of the last Read (or
+// write), so that Update and Delete only overwrite the meshObject Terraform planned against.
+const privateETagKey = "etag"
+
+// privateStateGetter is implemented by the private state of the Update and Delete requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the private state of the Create, Read and Update responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateETag returns the ETag kept by setPrivateETag, or an empty one making the write unconditional,
+// e.g. for state written by an older provider version.
+func getPrivateETag(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) (etag string) {
+	value, getDiags := private.GetKey(ctx, privateETagKey)
+	diags.Append(getDiags...)
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &etag); err != nil {
+			diags.AddError("Could not read ETag from private state", err.Error())
+		}
+	}
+	return
+}
+
+// setPrivateETag keeps etag for getPrivateETag.
+func setPrivateETag(ctx context.Context, private privateStateSetter, diags *diag.Diagnostics, etag string) {
+	value, _ := json.Marshal(etag) // cannot fail for a string
+	diags.Append(private.SetKey(ctx, privateETagKey, value)...)
+}
+
+// objectChangedDetail explains a 412 Precondition Failed of a write made conditional with the ETag of
+// getPrivateETag, instead of reporting the raw HTTP error.
+const objectChangedDetail = "object changed since last refresh, re-plan. It was modified after Terraform read it, " +
+	"by another resource of this apply or outside of Terraform."
+
+// addConditionalWriteError reports a failed write made conditional with the ETag of getPrivateETag,
+// prefixing the error with detail. See objectChangedDetail, and addApiErrorDiagnostics for data.
+func addConditionalWriteError(ctx context.Context, diags *diag.Diagnostics, data pathMatcher, summary, detail string, err error) {
+	if httpErr, ok := errors.AsType[client.HttpError](err); ok && httpErr.IsPreconditionFailed() {
+		diags.AddError(summary, fmt.Sprintf("%s: %s", detail, objectChangedDetail))
+		return
+	}
+	addApiErrorDiagnostics(ctx, diags, data, summary, fmt.Errorf("%s: %w", detail, err))
+}
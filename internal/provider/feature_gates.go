@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/client/version"
+)
+
+// featureGate is what meshStack needs to support for an attribute to take effect: a minimum version,
+// a feature flag in MeshInfo.EnabledFeatureFlags, or both. The meshObject API does not reject unknown
+// properties, so an older meshStack silently ignores an attribute it does not know yet.
+type featureGate struct {
+	Attribute   path.Expression
+	MinVersion  version.Version
+	FeatureFlag string
+}
+
+// featureGates lists the feature gates of attributes by resource type name, see checkFeatureGates. Each
+// resource listed must call checkFeatureGates in its ModifyPlan.
+//
+// Gate an attribute needing a newer meshStack than client.MinMeshStackVersion here instead of raising
+// the minimum for the whole provider. Gates at or below the minimum only apply with
+// MESHSTACK_SKIP_VERSION_CHECK, they are kept until the minimum has been raised well past them.
+var featureGates = map[string][]featureGate{
+	"meshstack_building_block": {
+		// Before, meshStack ignored parentBuildingBlockRefs, so the building block was created without parents.
+		{Attribute: path.MatchRoot("spec").AtName("parent_building_block_refs"), MinVersion: version.MustParse("2026.29.0")},
+	},
+	"meshstack_building_block_definition": {
+		// Before, meshStack only knew the deprecated dependencyDefinitionUuids.
+		{Attribute: path.MatchRoot("version_spec").AtName("dependency_refs"), MinVersion: version.MustParse("2026.29.0")},
+	},
+	"meshstack_landingzone": {
+		{Attribute: path.MatchRoot("spec").AtName("restricted"), MinVersion: version.MustParse("2026.34.0")},
+	},
+}
+
+// checkFeatureGates adds an error for every attribute of the resource type set in config which the
+// meshStack read by meshInfoClient does not support. Attributes set to their zero value, such as false
+// or an empty set, or to a value not known yet, do not need the feature.
+//
+// Call it in ModifyPlan, as ValidateConfig also runs without a configured provider, e.g. for terraform validate.
+func checkFeatureGates(ctx context.Context, meshInfoClient client.MeshInfoClient, typeName string, config tfsdk.Config, diags *diag.Diagnostics) {
+	gates := featureGates[typeName]
+	if len(gates) == 0 || meshInfoClient == nil || config.Raw.IsNull() {
+		return
+	}
+	var usedGates []featureGate
+	var usedPaths []path.Path
+	for _, gate := range gates {
+		paths, pathDiags := config.PathMatches(ctx, gate.Attribute)
+		diags.Append(pathDiags...)
+		for _, attributePath := range paths {
+			var value attr.Value
+			diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+			if isFeatureUsed(value) {
+				usedGates = append(usedGates, gate)
+				usedPaths = append(usedPaths, attributePath)
+			}
+		}
+	}
+	if len(usedGates) == 0 {
+		return
+	}
+
+	info, err := meshInfoClient.Read(ctx)
+	if err != nil {
+		diags.AddWarning("Cannot check meshStack features", fmt.Sprintf("Attributes requiring a newer meshStack might have no effect: %s", err.Error()))
+		return
+	}
+	meshVersion, err := version.Parse(info.Version)
+	if err != nil {
+		diags.AddWarning("Cannot check meshStack features", fmt.Sprintf("Attributes requiring a newer meshStack might have no effect: %s", err.Error()))
+		return
+	}
+	for i, gate := range usedGates {
+		if meshVersion.Less(gate.MinVersion) {
+			diags.AddAttributeError(usedPaths[i], "Attribute not supported by meshStack",
+				fmt.Sprintf("%s requires meshStack %s or later, but meshStack is running version %s. Remove the attribute or upgrade meshStack.", usedPaths[i], gate.MinVersion, meshVersion))
+		}
+		if gate.FeatureFlag != "" && !slices.Contains(info.EnabledFeatureFlags, gate.FeatureFlag) {
+			diags.AddAttributeError(usedPaths[i], "Attribute not supported by meshStack",
+				fmt.Sprintf("%s requires the meshStack feature %s, which is not enabled. Remove the attribute or ask your meshStack administrator to enable the feature.", usedPaths[i], gate.FeatureFlag))
+		}
+	}
+}
+
+// isFeatureUsed reports whether value is set to anything but its zero value.
+func isFeatureUsed(value attr.Value) bool {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	switch value := value.(type) {
+	case types.Bool:
+		return value.ValueBool()
+	case types.String:
+		return value.ValueString() != ""
+	case types.Set:
+		return len(value.Elements()) > 0
+	case types.List:
+		return len(value.Elements()) > 0
+	case types.Map:
+		return len(value.Elements()) > 0
+	}
+	return true
+}
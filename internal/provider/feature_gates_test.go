@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/client/version"
+)
+
+type featureGatesTestMeshInfoClient client.MeshInfo
+
+func (c featureGatesTestMeshInfoClient) Read(context.Context) (*client.MeshInfo, error) {
+	return new(client.MeshInfo(c)), nil
+}
+
+func TestCheckFeatureGates(t *testing.T) {
+	previousGates := featureGates
+	t.Cleanup(func() { featureGates = previousGates })
+	featureGates = map[string][]featureGate{
+		"meshstack_test": {
+			{Attribute: path.MatchRoot("spec").AtName("refs"), MinVersion: version.MustParse("2026.40.0")},
+			{Attribute: path.MatchRoot("spec").AtName("approved"), FeatureFlag: client.FeatureFlagFourEyesRoleApproval},
+		},
+	}
+
+	specType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"refs":     tftypes.Set{ElementType: tftypes.String},
+		"approved": tftypes.Bool,
+	}}
+	newConfig := func(refs, approved any) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+				"spec": schema.SingleNestedAttribute{Attributes: map[string]schema.Attribute{
+					"refs":     schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"approved": schema.BoolAttribute{Optional: true},
+				}, Optional: true},
+			}},
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"spec": specType}}, map[string]tftypes.Value{
+				"spec": tftypes.NewValue(specType, map[string]tftypes.Value{
+					"refs":     tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, refs),
+					"approved": tftypes.NewValue(tftypes.Bool, approved),
+				}),
+			}),
+		}
+	}
+	ref := tftypes.NewValue(tftypes.String, "some-ref")
+
+	tests := map[string]struct {
+		config     tfsdk.Config
+		info       client.MeshInfo
+		wantErrors []path.Path
+	}{
+		"unset attributes need no feature": {
+			config: newConfig(nil, nil),
+			info:   client.MeshInfo{Version: "2026.30.0"},
+		},
+		"zero values need no feature": {
+			config: newConfig([]tftypes.Value{}, false),
+			info:   client.MeshInfo{Version: "2026.30.0"},
+		},
+		"unknown values need no feature": {
+			config: newConfig(tftypes.UnknownValue, tftypes.UnknownValue),
+			info:   client.MeshInfo{Version: "2026.30.0"},
+		},
+		"supported": {
+			config: newConfig([]tftypes.Value{ref}, true),
+			info:   client.MeshInfo{Version: "2026.40.0", EnabledFeatureFlags: []string{client.FeatureFlagFourEyesRoleApproval}},
+		},
+		"too old and feature flag disabled": {
+			config: newConfig([]tftypes.Value{ref}, true),
+			info:   client.MeshInfo{Version: "2026.39.1", EnabledFeatureFlags: []string{}},
+			wantErrors: []path.Path{
+				path.Root("spec").AtName("refs"),
+				path.Root("spec").AtName("approved"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkFeatureGates(t.Context(), featureGatesTestMeshInfoClient(test.info), "meshstack_test", test.config, &diags)
+			require.Len(t, diags.Errors(), len(test.wantErrors), "%v", diags)
+			for i, wantPath := range test.wantErrors {
+				assert.Contains(t, diags.Errors()[i].Detail(), wantPath.String())
+			}
+		})
+	}
+}
+
+func TestFeatureGatesOfResources(t *testing.T) {
+	resources := map[string]func(meshInfoClient client.MeshInfoClient) resource.ResourceWithModifyPlan{
+		"meshstack_building_block": func(meshInfoClient client.MeshInfoClient) resource.ResourceWithModifyPlan {
+			return &buildingBlockResource{meshInfoClient: meshInfoClient}
+		},
+		"meshstack_building_block_definition": func(meshInfoClient client.MeshInfoClient) resource.ResourceWithModifyPlan {
+			return &buildingBlockDefinitionResource{meshInfoClient: meshInfoClient}
+		},
+		"meshstack_landingzone": func(meshInfoClient client.MeshInfoClient) resource.ResourceWithModifyPlan {
+			return &landingZoneResource{meshInfoClient: meshInfoClient}
+		},
+	}
+	for typeName, gates := range featureGates {
+		newResource, ok := resources[typeName]
+		require.True(t, ok, "resource %s with feature gates must be tested here", typeName)
+		for _, gate := range gates {
+			t.Run(fmt.Sprintf("%s %s", typeName, gate.Attribute), func(t *testing.T) {
+				resourceSchema := ResourceSchemaForTest(t, newResource(nil))
+				var diags diag.Diagnostics
+				raw := featureGatesTestValue(t, resourceSchema.Type().TerraformType(t.Context()), gate.Attribute.Resolve().Steps())
+				config := tfsdk.Config{Schema: resourceSchema, Raw: raw}
+
+				olderVersion := gate.MinVersion
+				olderVersion.Minor--
+				req := resource.ModifyPlanRequest{Config: config, Plan: tfsdk.Plan{Schema: resourceSchema, Raw: raw}, State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(raw.Type(), nil)}}
+				resp := resource.ModifyPlanResponse{Plan: req.Plan}
+				newResource(featureGatesTestMeshInfoClient(client.MeshInfo{Version: olderVersion.String()})).ModifyPlan(t.Context(), req, &resp)
+				require.Len(t, resp.Diagnostics.Errors(), 1, "%v", resp.Diagnostics)
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), fmt.Sprintf("requires meshStack %s or later", gate.MinVersion))
+
+				checkFeatureGates(t.Context(), featureGatesTestMeshInfoClient(client.MeshInfo{Version: gate.MinVersion.String()}), typeName, config, &diags)
+				assert.False(t, diags.HasError(), "%v", diags)
+			})
+		}
+	}
+}
+
+// featureGatesTestValue returns a null value of the object type typ, but with the attribute at steps set
+// to a value using the feature.
+func featureGatesTestValue(t *testing.T, typ tftypes.Type, steps path.ExpressionSteps) tftypes.Value {
+	t.Helper()
+	if len(steps) == 0 {
+		return featureGatesTestUsedValue(t, typ)
+	}
+	objectType, ok := typ.(tftypes.Object)
+	require.True(t, ok, "expected object for %s, got %s", steps, typ)
+	name, ok := steps[0].(path.ExpressionStepAttributeNameExact)
+	require.True(t, ok, "expected attribute name, got %s", steps[0])
+	values := map[string]tftypes.Value{}
+	for attributeName, attributeType := range objectType.AttributeTypes {
+		values[attributeName] = tftypes.NewValue(attributeType, nil)
+	}
+	values[string(name)] = featureGatesTestValue(t, objectType.AttributeTypes[string(name)], steps[1:])
+	return tftypes.NewValue(objectType, values)
+}
+
+// featureGatesTestUsedValue returns a value of typ which is not the zero value, see isFeatureUsed.
+func featureGatesTestUsedValue(t *testing.T, typ tftypes.Type) tftypes.Value {
+	t.Helper()
+	switch typ := typ.(type) {
+	case tftypes.Set:
+		return tftypes.NewValue(typ, []tftypes.Value{featureGatesTestUsedValue(t, typ.ElementType)})
+	case tftypes.List:
+		return tftypes.NewValue(typ, []tftypes.Value{featureGatesTestUsedValue(t, typ.ElementType)})
+	case tftypes.Object:
+		values := map[string]tftypes.Value{}
+		for attributeName, attributeType := range typ.AttributeTypes {
+			values[attributeName] = featureGatesTestUsedValue(t, attributeType)
+		}
+		return tftypes.NewValue(typ, values)
+	}
+	switch {
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, true)
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, "some-value")
+	}
+	require.Failf(t, "unsupported type", "%s", typ)
+	return tftypes.Value{}
+}
@@ -18,6 +18,7 @@ var (
 	_ resource.ResourceWithConfigure   = &integrationResource{}
 	_ resource.ResourceWithImportState = &integrationResource{}
 	_ resource.ResourceWithModifyPlan  = &integrationResource{}
+	_ resource.ResourceWithIdentity    = &integrationResource{}
 )
 
 func NewIntegrationResource() resource.Resource {
@@ -72,6 +73,10 @@ func (model *integrationModel) SetFromClientDto(dto *client.MeshIntegration) {
 	model.Ref.Uuid = *dto.Metadata.Uuid
 }
 
+func (r *integrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("integration")
+}
+
 func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	plan := generic.Get[integrationModel](ctx, req.Plan, &resp.Diagnostics,
 		secret.WithConverterSupport(ctx, req.Config, req.Plan, nil).Append(generic.WithSetUnknownValueToZero())...)
@@ -80,11 +85,12 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	createdDto, err := r.integrationClient.Create(ctx, plan.ToClientDto())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating meshIntegration", err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating meshIntegration", err)
 		return
 	}
 	plan.SetFromClientDto(createdDto)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, secret.WithConverterSupport(ctx, req.Config, req.Plan, nil)...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *createdDto.Metadata.Uuid})...)
 }
 
 func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -103,6 +109,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	var state integrationModel
 	state.SetFromClientDto(readDto)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, state, secret.WithConverterSupport(ctx, nil, nil, req.State)...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: uuid})...)
 }
 
 func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -123,11 +130,12 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	updatedDto, err := r.integrationClient.Update(ctx, plan.ToClientDto())
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating meshIntegration", fmt.Sprintf("Updating integration '%s' failed: %s", *plan.Metadata.Uuid, err.Error()))
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating meshIntegration", fmt.Errorf("updating integration '%s' failed: %w", *plan.Metadata.Uuid, err))
 		return
 	}
 	plan.MeshIntegration = *updatedDto
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, secret.WithConverterSupport(ctx, req.Config, req.Plan, nil)...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *updatedDto.Metadata.Uuid})...)
 }
 
 func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -142,5 +150,5 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("uuid"), path.Root("uuid"), req, resp)
 }
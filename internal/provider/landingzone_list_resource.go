@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ list.ListResource              = &landingZoneListResource{}
+	_ list.ListResourceWithConfigure = &landingZoneListResource{}
+)
+
+func NewLandingZoneListResource() list.ListResource {
+	return &landingZoneListResource{}
+}
+
+type landingZoneListResource struct {
+	meshLandingZoneClient client.MeshLandingZoneClient
+}
+
+func (r *landingZoneListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_landingzone"
+}
+
+func (r *landingZoneListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.meshLandingZoneClient = client.LandingZone
+	})...)
+}
+
+func (r *landingZoneListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists landing zones with optional filters.",
+		Attributes: map[string]schema.Attribute{
+			"platform_uuid": schema.StringAttribute{
+				MarkdownDescription: "Filter to the landing zones of the platform with this uuid.",
+				Optional:            true,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Filter by landing zone identifier (`metadata.name`).",
+				Optional:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Filter by display name.",
+				Optional:            true,
+			},
+			"restricted": schema.BoolAttribute{
+				MarkdownDescription: "Filter by restriction: `true` returns only restricted landing zones, `false` only unrestricted ones.",
+				Optional:            true,
+			},
+			"owned_by_workspace": schema.StringAttribute{
+				MarkdownDescription: "Filter by the identifier of the workspace that owns the landing zone.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *landingZoneListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var query client.MeshLandingZoneListQuery
+	var diags diag.Diagnostics
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("platform_uuid"), &query.PlatformUuid)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("identifier"), &query.Identifier)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("display_name"), &query.DisplayName)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("restricted"), &query.Restricted)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("owned_by_workspace"), &query.OwnedByWorkspace)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.meshLandingZoneClient.All(ctx, query), "Unable to list landing zones", func(landingZone client.MeshLandingZone, result *list.ListResult) {
+		result.DisplayName = landingZone.Spec.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, nameIdentity{Name: landingZone.Metadata.Name})...)
+		if req.IncludeResource {
+			// As on import, all tags the API returns are kept.
+			result.Diagnostics.Append(result.Resource.Set(ctx, landingZoneModelFrom(&landingZone))...)
+		}
+	})
+}
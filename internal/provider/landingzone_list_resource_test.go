@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+func TestLandingZoneListResource(t *testing.T) {
+	ctx := context.Background()
+	mockClient := clientmock.NewMock()
+	for _, name := range []string{"lz-a", "lz-b"} {
+		_, err := mockClient.LandingZone.Create(ctx, &client.MeshLandingZoneCreate{
+			Metadata: client.MeshLandingZoneMetadata{Name: name, OwnedByWorkspace: "my-workspace", Tags: map[string][]string{}},
+			Spec: client.MeshLandingZoneSpec{
+				DisplayName: "Landing zone " + name,
+				PlatformRef: client.UuidRef{Uuid: "platform-uuid", Kind: client.MeshObjectKind.Platform},
+			},
+		})
+		require.NoError(t, err)
+	}
+	landingZoneClient := &recordingLandingZoneClient{MeshLandingZoneClient: mockClient.LandingZone}
+	listResource := &landingZoneListResource{meshLandingZoneClient: landingZoneClient}
+
+	testListResource(t, NewLandingZoneResource(), listResource, &landingZoneClient.query, func(t *testing.T, result list.ListResult) {
+		var identity nameIdentity
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		assert.Equal(t, identity.Name, listedAttribute(t, result, path.Root("metadata").AtName("name")))
+		assert.Equal(t, "platform-uuid", listedAttribute(t, result, path.Root("spec").AtName("platform_ref").AtName("uuid")))
+	}, listResourceCase[client.MeshLandingZoneListQuery]{
+		name: "filters",
+		config: map[string]tftypes.Value{
+			"platform_uuid":      tftypes.NewValue(tftypes.String, "platform-uuid"),
+			"identifier":         tftypes.NewValue(tftypes.String, "lz-a"),
+			"display_name":       tftypes.NewValue(tftypes.String, "Landing zone lz-a"),
+			"restricted":         tftypes.NewValue(tftypes.Bool, false),
+			"owned_by_workspace": tftypes.NewValue(tftypes.String, "my-workspace"),
+		},
+		wantQuery: client.MeshLandingZoneListQuery{
+			PlatformUuid:     new("platform-uuid"),
+			Identifier:       new("lz-a"),
+			DisplayName:      new("Landing zone lz-a"),
+			Restricted:       new(false),
+			OwnedByWorkspace: new("my-workspace"),
+		},
+		wantDisplayNames: []string{"Landing zone lz-a"},
+	}, listResourceCase[client.MeshLandingZoneListQuery]{
+		name:             "include resource",
+		includeResource:  true,
+		wantDisplayNames: []string{"Landing zone lz-a", "Landing zone lz-b"},
+	})
+}
@@ -27,6 +27,8 @@ var (
 	_ resource.ResourceWithConfigure    = &landingZoneResource{}
 	_ resource.ResourceWithImportState  = &landingZoneResource{}
 	_ resource.ResourceWithUpgradeState = &landingZoneResource{}
+	_ resource.ResourceWithModifyPlan   = &landingZoneResource{}
+	_ resource.ResourceWithIdentity     = &landingZoneResource{}
 )
 
 // NewLandingZoneResource is a helper function to simplify the provider implementation.
@@ -37,6 +39,7 @@ func NewLandingZoneResource() resource.Resource {
 // landingZoneResource is the resource implementation.
 type landingZoneResource struct {
 	meshLandingZoneClient client.MeshLandingZoneClient
+	meshInfoClient        client.MeshInfoClient
 }
 
 // landingZoneRefOutput is the computed self-`ref` of a landing zone (name-based).
@@ -72,6 +75,7 @@ func (r *landingZoneResource) Metadata(_ context.Context, req resource.MetadataR
 func (r *landingZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
 		r.meshLandingZoneClient = client.LandingZone
+		r.meshInfoClient = client.MeshInfo
 	})...)
 }
 
@@ -481,6 +485,19 @@ func customPlatformConfigSchema() schema.Attribute {
 	}
 }
 
+// ModifyPlan rejects attributes the configured meshStack does not support yet, see featureGates.
+func (r *landingZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// do nothing in case of delete
+		return
+	}
+	checkFeatureGates(ctx, r.meshInfoClient, "meshstack_landingzone", req.Config, &resp.Diagnostics)
+}
+
+func (r *landingZoneResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("landing zone")
+}
+
 func (r *landingZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	landingZone := client.MeshLandingZoneCreate{
 		Metadata: client.MeshLandingZoneMetadata{},
@@ -496,10 +513,7 @@ func (r *landingZoneResource) Create(ctx context.Context, req resource.CreateReq
 
 	createdLandingZone, err := r.meshLandingZoneClient.Create(ctx, &landingZone)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Landing Zone",
-			"Could not create landing zone, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Creating Landing Zone", err)
 		return
 	}
 
@@ -508,6 +522,7 @@ func (r *landingZoneResource) Create(ctx context.Context, req resource.CreateReq
 	createdLandingZone.Metadata.Tags = landingZone.Metadata.Tags
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, landingZoneModelFrom(createdLandingZone))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: createdLandingZone.Metadata.Name})...)
 }
 
 func (r *landingZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -520,7 +535,7 @@ func (r *landingZoneResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	landingZone, err := r.meshLandingZoneClient.Read(ctx, name)
+	landingZone, etag, err := r.meshLandingZoneClient.ReadWithETag(ctx, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Could not read landing zone '%s'", name),
@@ -545,6 +560,8 @@ func (r *landingZoneResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, landingZoneModelFrom(landingZone))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: landingZone.Metadata.Name})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, etag)
 }
 
 func (r *landingZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -560,12 +577,14 @@ func (r *landingZoneResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	updatedLandingZone, err := r.meshLandingZoneClient.Update(ctx, landingZone.Metadata.Name, &landingZone)
+	etag := getPrivateETag(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedLandingZone, newETag, err := r.meshLandingZoneClient.UpdateIfMatch(ctx, landingZone.Metadata.Name, etag, &landingZone)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Landing Zone",
-			"Could not update landing zone, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Updating Landing Zone", err)
 		return
 	}
 
@@ -573,6 +592,8 @@ func (r *landingZoneResource) Update(ctx context.Context, req resource.UpdateReq
 	updatedLandingZone.Metadata.Tags = landingZone.Metadata.Tags
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, landingZoneModelFrom(updatedLandingZone))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: updatedLandingZone.Metadata.Name})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, newETag)
 }
 
 func (r *landingZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -595,7 +616,7 @@ func (r *landingZoneResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *landingZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("name"), path.Root("name"), req, resp)
 }
 
 func (r *landingZoneResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
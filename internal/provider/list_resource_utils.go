@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// listResults streams a list result per item for `terraform query`. setResult fills in the identity and
+// display name of the result, and the resource if req.IncludeResource is set. The stream ends at the limit
+// Terraform asks for, or at the first error, which is reported with errorSummary.
+func listResults[T any](ctx context.Context, req list.ListRequest, items iter.Seq2[T, error], errorSummary string, setResult func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for item, err := range items {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError(errorSummary, err.Error())
+				push(result)
+				return
+			}
+			result := req.NewListResult(ctx)
+			setResult(item, &result)
+			if !push(result) {
+				return
+			}
+			if count++; req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
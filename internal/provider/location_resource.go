@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &locationResource{}
 	_ resource.ResourceWithConfigure   = &locationResource{}
 	_ resource.ResourceWithImportState = &locationResource{}
+	_ resource.ResourceWithIdentity    = &locationResource{}
 )
 
 func NewLocationResource() resource.Resource {
@@ -115,6 +116,10 @@ func (r *locationResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *locationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("location")
+}
+
 func (r *locationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var name string
 	var ownedByWorkspace string
@@ -143,10 +148,7 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 
 	createdLocation, err := r.meshLocationClient.Create(ctx, &location)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Location",
-			"Could not create location, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Creating Location", err)
 		return
 	}
 
@@ -159,6 +161,7 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: createdLocation.Metadata.Name})...)
 }
 
 func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -192,6 +195,7 @@ func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: location.Metadata.Name})...)
 }
 
 func (r *locationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -224,10 +228,7 @@ func (r *locationResource) Update(ctx context.Context, req resource.UpdateReques
 
 	updatedLocation, err := r.meshLocationClient.Update(ctx, stateName, &location)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Location",
-			"Could not update location, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Updating Location", err)
 		return
 	}
 
@@ -240,6 +241,7 @@ func (r *locationResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: updatedLocation.Metadata.Name})...)
 }
 
 func (r *locationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -261,5 +263,5 @@ func (r *locationResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *locationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("name"), path.Root("name"), req, resp)
 }
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ resource.Resource                   = &meshObjectResource{}
+	_ resource.ResourceWithConfigure      = &meshObjectResource{}
+	_ resource.ResourceWithImportState    = &meshObjectResource{}
+	_ resource.ResourceWithValidateConfig = &meshObjectResource{}
+	_ resource.ResourceWithIdentity       = &meshObjectResource{}
+)
+
+func NewMeshObjectResource() resource.Resource {
+	return &meshObjectResource{}
+}
+
+type meshObjectResource struct {
+	meshObjectClient client.MeshObjectClient
+}
+
+type meshObjectResourceModel struct {
+	ApiVersion types.String         `tfsdk:"api_version"`
+	Kind       types.String         `tfsdk:"kind"`
+	Identifier types.String         `tfsdk:"identifier"`
+	Manifest   jsontypes.Normalized `tfsdk:"manifest"`
+	Object     jsontypes.Normalized `tfsdk:"object"`
+}
+
+// meshObjectIdentity identifies a meshObject of any kind by the attributes of its import ID.
+type meshObjectIdentity struct {
+	Kind       string `tfsdk:"kind"`
+	ApiVersion string `tfsdk:"api_version"`
+	Identifier string `tfsdk:"identifier"`
+}
+
+func (m meshObjectResourceModel) identity() meshObjectIdentity {
+	return meshObjectIdentity{Kind: m.Kind.ValueString(), ApiVersion: m.ApiVersion.ValueString(), Identifier: m.Identifier.ValueString()}
+}
+
+func (r *meshObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meshobject"
+}
+
+func (r *meshObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.meshObjectClient = client.MeshObject
+	})...)
+}
+
+func (r *meshObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a meshObject of any kind through the meshObject API, e.g. of a kind without a dedicated resource yet. " +
+			"Prefer the dedicated resources where they exist, as they validate the meshObject at plan time and know which fields meshStack sets.\n\n" +
+			"The `manifest` is sent as is, with `apiVersion` and `kind` set from the attributes of the same name. " +
+			"Fields meshStack adds to the meshObject, such as `metadata.uuid` or `metadata.createdOn`, and its `status` are not part of the diff, " +
+			"so only the fields in the `manifest` are compared with the meshObject in meshStack. " +
+			"An imported meshObject's `manifest` contains all its fields but the `status`, so the first apply after the import plans an update to the configured `manifest`.",
+
+		Attributes: map[string]schema.Attribute{
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "API version of the meshObject, e.g. `v1` or `v2-preview`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^v[0-9]+(-preview)?$`), "must be a meshObject API version, e.g. v1 or v2-preview"),
+				},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "Kind of the meshObject, e.g. `meshProjectRole`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^mesh[A-Z][A-Za-z]*$`), "must be a meshObject kind, e.g. meshProjectRole"),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the meshObject in the meshObject API path, `/api/meshobjects/<kind>s/<identifier>`. " +
+					"If not set, the `metadata.uuid` meshStack assigns on creation is used, or `metadata.name` for kinds without one. " +
+					"Set it for kinds addressed by their name although they have a UUID, e.g. `meshWorkspace`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "JSON of the meshObject to send, usually with `metadata` and `spec`, e.g. `jsonencode({ metadata = { name = \"reader\" }, spec = { ... } })`. " +
+					"Must not contain `status`, which meshStack owns. " +
+					"Sensitive, as a meshObject may carry secrets, so plans do not show its changes.",
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+				Sensitive:  true,
+			},
+			"object": schema.StringAttribute{
+				MarkdownDescription: "JSON of the meshObject as served by meshStack, including the fields meshStack sets and the `status`. " +
+					"Read it with `jsondecode(...)`.",
+				CustomType: jsontypes.NormalizedType{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *meshObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data meshObjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Manifest.IsUnknown() || data.Manifest.IsNull() {
+		return
+	}
+	manifest, ok := decodeMeshObjectJson(data.Manifest.ValueString(), path.Root("manifest"), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if _, ok := manifest["status"]; ok {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid meshObject manifest", "The manifest must not contain status, which meshStack owns. Read it from the object attribute instead.")
+	}
+	for key, attribute := range map[string]types.String{"apiVersion": data.ApiVersion, "kind": data.Kind} {
+		if value, ok := manifest[key]; ok && !attribute.IsUnknown() && value != attribute.ValueString() {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid meshObject manifest",
+				fmt.Sprintf("The manifest's %s '%v' differs from '%s' set by the %s attribute. Remove it from the manifest.", key, value, attribute.ValueString(), camelToSnakeCase(key)))
+		}
+	}
+}
+
+func (r *meshObjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"kind": identityschema.StringAttribute{
+				Description:       "Kind of the meshObject, e.g. `meshProjectRole`.",
+				RequiredForImport: true,
+			},
+			"api_version": identityschema.StringAttribute{
+				Description:       "API version of the meshObject kind, e.g. `v1`.",
+				RequiredForImport: true,
+			},
+			"identifier": identityschema.StringAttribute{
+				Description:       "Identifier of the meshObject, its metadata.uuid or metadata.name.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *meshObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan meshObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.meshObjectClient.Create(ctx, plan.Kind.ValueString(), plan.ApiVersion.ValueString(), json.RawMessage(plan.Manifest.ValueString()))
+	if err != nil {
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, fmt.Sprintf("Could not create %s", plan.Kind.ValueString()), err)
+		return
+	}
+	object, ok := decodeMeshObjectJson(string(created), path.Empty(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if plan.Identifier.IsUnknown() {
+		metadata, _ := object["metadata"].(map[string]any)
+		identifier, _ := metadata["uuid"].(string)
+		if identifier == "" {
+			identifier, _ = metadata["name"].(string)
+		}
+		if identifier == "" {
+			resp.Diagnostics.AddError(fmt.Sprintf("Created %s without identifier", plan.Kind.ValueString()),
+				"meshStack returned the meshObject without metadata.uuid or metadata.name. Set the identifier attribute to manage it.")
+			return
+		}
+		plan.Identifier = types.StringValue(identifier)
+	}
+	plan.Object = meshObjectJsonValue(object, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+func (r *meshObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state meshObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := r.meshObjectClient.Read(ctx, state.Kind.ValueString(), state.ApiVersion.ValueString(), state.Identifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not read %s '%s'", state.Kind.ValueString(), state.Identifier.ValueString()), err.Error())
+		return
+	}
+	if read == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	object, ok := decodeMeshObjectJson(string(read), path.Empty(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if state.Manifest.IsNull() {
+		// Imported, take everything but what meshStack owns for sure.
+		manifest := map[string]any{}
+		for key, value := range object {
+			if key != "status" && key != "apiVersion" && key != "kind" {
+				manifest[key] = value
+			}
+		}
+		state.Manifest = meshObjectJsonValue(manifest, &resp.Diagnostics)
+	} else {
+		var manifest map[string]any
+		if err := unmarshalJsonNumbers([]byte(state.Manifest.ValueString()), &manifest); err != nil {
+			resp.Diagnostics.AddError("Invalid meshObject manifest in state", err.Error())
+			return
+		}
+		// Only drift in the fields of the manifest shows as a diff, not the fields meshStack sets.
+		if projected, _ := projectMeshObject(manifest, object).(map[string]any); !reflect.DeepEqual(projected, manifest) {
+			state.Manifest = meshObjectJsonValue(projected, &resp.Diagnostics)
+		}
+	}
+	state.Object = meshObjectJsonValue(object, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+func (r *meshObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan meshObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.meshObjectClient.Update(ctx, plan.Kind.ValueString(), plan.ApiVersion.ValueString(), plan.Identifier.ValueString(), json.RawMessage(plan.Manifest.ValueString()))
+	if err != nil {
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, fmt.Sprintf("Could not update %s '%s'", plan.Kind.ValueString(), plan.Identifier.ValueString()), err)
+		return
+	}
+	object, ok := decodeMeshObjectJson(string(updated), path.Empty(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	plan.Object = meshObjectJsonValue(object, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+func (r *meshObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state meshObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.meshObjectClient.Delete(ctx, state.Kind.ValueString(), state.ApiVersion.ValueString(), state.Identifier.ValueString()); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not delete %s '%s'", state.Kind.ValueString(), state.Identifier.ValueString()), err.Error())
+	}
+}
+
+// ImportState imports a meshObject by an ID of the form <kind>/<api_version>/<identifier>, e.g. meshProjectRole/v1/reader,
+// or by the same attributes given as identity.
+func (r *meshObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identity := importIdentity(ctx, req, &resp.Diagnostics, func(id string, diags *diag.Diagnostics) meshObjectIdentity {
+		parts := strings.SplitN(id, "/", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			diags.AddError("Invalid import ID", fmt.Sprintf("Expected <kind>/<api_version>/<identifier>, e.g. meshProjectRole/v1/reader, got '%s'.", id))
+			return meshObjectIdentity{}
+		}
+		return meshObjectIdentity{Kind: parts[0], ApiVersion: parts[1], Identifier: parts[2]}
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kind"), identity.Kind)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_version"), identity.ApiVersion)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identity.Identifier)...)
+}
+
+// projectMeshObject returns object reduced to the fields of manifest, recursively. Fields of manifest
+// missing in object are kept as in manifest, as meshStack does not return write-only fields, e.g. secrets.
+// List elements are projected by index up to the length of manifest, so that elements meshStack appends,
+// e.g. defaulted ones, are dropped. A shorter list in object shows as a diff.
+func projectMeshObject(manifest, object any) any {
+	switch manifest := manifest.(type) {
+	case map[string]any:
+		object, ok := object.(map[string]any)
+		if !ok {
+			return object
+		}
+		projected := make(map[string]any, len(manifest))
+		for key, value := range manifest {
+			if objectValue, ok := object[key]; ok {
+				projected[key] = projectMeshObject(value, objectValue)
+			} else {
+				projected[key] = value
+			}
+		}
+		return projected
+	case []any:
+		object, ok := object.([]any)
+		if !ok {
+			return object
+		}
+		projected := make([]any, min(len(manifest), len(object)))
+		for i := range projected {
+			projected[i] = projectMeshObject(manifest[i], object[i])
+		}
+		return projected
+	default:
+		return object
+	}
+}
+
+// decodeMeshObjectJson decodes a meshObject, dropping its HAL _links. Numbers are decoded as json.Number,
+// see unmarshalJsonNumbers.
+func decodeMeshObjectJson(s string, attributePath path.Path, diags *diag.Diagnostics) (map[string]any, bool) {
+	var object map[string]any
+	if err := unmarshalJsonNumbers([]byte(s), &object); err != nil || object == nil {
+		detail := "expected a JSON object"
+		if err != nil {
+			detail = err.Error()
+		}
+		if attributePath.Equal(path.Empty()) {
+			diags.AddError("Invalid meshObject JSON", detail)
+		} else {
+			diags.AddAttributeError(attributePath, "Invalid meshObject JSON", detail)
+		}
+		return nil, false
+	}
+	delete(object, "_links")
+	return object, true
+}
+
+// unmarshalJsonNumbers is json.Unmarshal decoding numbers as json.Number, which unlike float64 keeps
+// integers above 2^53 exact.
+func unmarshalJsonNumbers(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("invalid data after top-level JSON value")
+	}
+	return nil
+}
+
+func meshObjectJsonValue(object map[string]any, diags *diag.Diagnostics) jsontypes.Normalized {
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		diags.AddError("Cannot encode meshObject JSON", err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/meshcloud/terraform-provider-meshstack/internal/provider/acctest/testconfig"
+)
+
+func TestAccMeshObject(t *testing.T) {
+	var meshObjectAddr testconfig.Traversal
+	name := "auditor-" + acctest.RandString(16)
+	manifest := func(displayName string) testconfig.ExpressionConsumer {
+		return testconfig.SetRawExpr(`jsonencode({ metadata = { name = %q }, spec = { displayName = %q } })`, name, displayName)
+	}
+	config := testconfig.Resource{Name: "meshobject"}.Config(t).WithFirstBlock(
+		testconfig.ExtractAddress(&meshObjectAddr),
+		testconfig.Descend("identifier")(testconfig.SetString(name)),
+		testconfig.Descend("manifest")(manifest("Auditor")),
+	)
+	updateConfig := config.WithFirstBlock(testconfig.Descend("manifest")(manifest("Updated Auditor")))
+
+	ApplyAndTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: config.String(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(meshObjectAddr.String(), plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(meshObjectAddr.String(), tfjsonpath.New("identifier"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(meshObjectAddr.String(), tfjsonpath.New("object"), knownvalue.StringRegexp(regexp.MustCompile(regexp.QuoteMeta(`"displayName":"Auditor"`)))),
+				},
+			},
+			{
+				Config: updateConfig.String(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(meshObjectAddr.String(), plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(meshObjectAddr.String(), tfjsonpath.New("object"), knownvalue.StringRegexp(regexp.MustCompile(regexp.QuoteMeta(`"displayName":"Updated Auditor"`)))),
+				},
+			},
+			{
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   fmt.Sprintf("meshProjectRole/v1/%s", name),
+				ResourceName:    meshObjectAddr.String(),
+				// The imported manifest also has the fields meshStack set, e.g. metadata.uuid.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestProjectMeshObject(t *testing.T) {
+	manifest := map[string]any{
+		"metadata": map[string]any{"name": "auditor"},
+		"spec": map[string]any{
+			"displayName": "Auditor",
+			"secret":      "write-only",
+			"tags":        []any{map[string]any{"key": "a"}},
+		},
+	}
+	object := map[string]any{
+		"metadata": map[string]any{"name": "auditor", "uuid": "some-uuid", "createdOn": "2026-01-01T00:00:00Z"},
+		"spec": map[string]any{
+			"displayName": "Changed Auditor",
+			"tags":        []any{map[string]any{"key": "a", "addedByMeshStack": true}},
+		},
+		"status": map[string]any{"ready": true},
+	}
+	assert.Equal(t, map[string]any{
+		"metadata": map[string]any{"name": "auditor"},
+		"spec": map[string]any{
+			"displayName": "Changed Auditor",
+			"secret":      "write-only",
+			"tags":        []any{map[string]any{"key": "a"}},
+		},
+	}, projectMeshObject(manifest, object))
+
+	object["spec"].(map[string]any)["tags"] = []any{}                                                               //nolint:forcetypeassert // set above
+	assert.Equal(t, []any{}, projectMeshObject(manifest, object).(map[string]any)["spec"].(map[string]any)["tags"]) //nolint:forcetypeassert // maps as in manifest
+
+	// An element meshStack appended, e.g. a defaulted one, is not a diff.
+	object["spec"].(map[string]any)["tags"] = []any{map[string]any{"key": "a", "addedByMeshStack": true}, map[string]any{"key": "default"}}   //nolint:forcetypeassert // set above
+	assert.Equal(t, []any{map[string]any{"key": "a"}}, projectMeshObject(manifest, object).(map[string]any)["spec"].(map[string]any)["tags"]) //nolint:forcetypeassert // maps as in manifest
+}
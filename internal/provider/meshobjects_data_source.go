@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ datasource.DataSource              = &meshObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &meshObjectsDataSource{}
+)
+
+func NewMeshObjectsDataSource() datasource.DataSource {
+	return &meshObjectsDataSource{}
+}
+
+type meshObjectsDataSource struct {
+	meshObjectClient client.MeshObjectClient
+}
+
+type meshObjectsDataSourceModel struct {
+	ApiVersion types.String  `tfsdk:"api_version"`
+	Kind       types.String  `tfsdk:"kind"`
+	Query      types.Map     `tfsdk:"query"`
+	Items      types.Dynamic `tfsdk:"items"`
+}
+
+func (d *meshObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meshobjects"
+}
+
+func (d *meshObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the meshObjects of any kind through the meshObject API, e.g. of a kind without a dedicated data source yet. " +
+			"Prefer the dedicated data sources where they exist, as their attributes are typed and documented.",
+
+		Attributes: map[string]schema.Attribute{
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "API version of the meshObjects, e.g. `v1` or `v2-preview`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^v[0-9]+(-preview)?$`), "must be a meshObject API version, e.g. v1 or v2-preview"),
+				},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "Kind of the meshObjects, e.g. `meshProjectRole`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^mesh[A-Z][A-Za-z]*$`), "must be a meshObject kind, e.g. meshProjectRole"),
+				},
+			},
+			"query": schema.MapAttribute{
+				MarkdownDescription: "Query parameters of the list request, e.g. `{ workspaceIdentifier = \"my-workspace\" }`. " +
+					"The filters available depend on the kind, see the meshObject API documentation of your meshStack.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"items": schema.DynamicAttribute{
+				MarkdownDescription: "The meshObjects as served by meshStack, as a list of objects, e.g. `[for role in data.meshstack_meshobjects.roles.items : role.metadata.name]`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *meshObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		d.meshObjectClient = client.MeshObject
+	})...)
+}
+
+func (d *meshObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data meshObjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	query := map[string]string{}
+	if !data.Query.IsNull() {
+		resp.Diagnostics.Append(data.Query.ElementsAs(ctx, &query, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := d.meshObjectClient.List(ctx, data.Kind.ValueString(), data.ApiVersion.ValueString(), query)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not list %s", data.Kind.ValueString()), err.Error())
+		return
+	}
+	elements := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		object, ok := decodeMeshObjectJson(string(item), path.Empty(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		elements = append(elements, jsonToAttrValue(object, &resp.Diagnostics))
+	}
+	elementTypes := make([]attr.Type, len(elements))
+	for i, element := range elements {
+		elementTypes[i] = element.Type(ctx)
+	}
+	tuple, diags := types.TupleValue(elementTypes, elements)
+	resp.Diagnostics.Append(diags...)
+	data.Items = types.DynamicValue(tuple)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// jsonToAttrValue converts a decoded JSON value to the Terraform value jsondecode(...) would return: objects
+// become objects, arrays tuples, and null a null string, as a dynamic value needs a concrete type. Numbers
+// must be decoded as json.Number, see decodeMeshObjectJson.
+func jsonToAttrValue(value any, diags *diag.Diagnostics) attr.Value {
+	switch value := value.(type) {
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(value))
+		attributes := make(map[string]attr.Value, len(value))
+		for key, element := range value {
+			attributes[key] = jsonToAttrValue(element, diags)
+			attributeTypes[key] = attributes[key].Type(context.Background())
+		}
+		object, objectDiags := types.ObjectValue(attributeTypes, attributes)
+		diags.Append(objectDiags...)
+		return object
+	case []any:
+		elementTypes := make([]attr.Type, len(value))
+		elements := make([]attr.Value, len(value))
+		for i, element := range value {
+			elements[i] = jsonToAttrValue(element, diags)
+			elementTypes[i] = elements[i].Type(context.Background())
+		}
+		tuple, tupleDiags := types.TupleValue(elementTypes, elements)
+		diags.Append(tupleDiags...)
+		return tuple
+	case string:
+		return types.StringValue(value)
+	case bool:
+		return types.BoolValue(value)
+	case json.Number:
+		// The precision of Terraform's numbers, so that large integers stay exact.
+		number, _, err := big.ParseFloat(value.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			diags.AddError("Invalid number in meshObject JSON", err.Error())
+			return types.NumberNull()
+		}
+		return types.NumberValue(number)
+	default:
+		return types.StringNull()
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/provider/acctest/testconfig"
+)
+
+// TestMeshObjectsDataSource pre-populates the mock store, as the other kinds in it are not visible to the data source.
+func TestMeshObjectsDataSource(t *testing.T) {
+	t.Parallel()
+
+	mockClient := clientmock.NewMock()
+	for _, name := range []string{"reader", "admin"} {
+		_, err := mockClient.MeshObject.Create(t.Context(), "meshProjectRole", "v1", []byte(`{"metadata":{"name":"`+name+`"},"spec":{"displayName":"`+name+`","restricted":false}}`))
+		assert.NoError(t, err)
+	}
+	_, err := mockClient.MeshObject.Create(t.Context(), "meshUser", "v1", []byte(`{"metadata":{"name":"jdoe"}}`))
+	assert.NoError(t, err)
+
+	config := testconfig.DataSource{Name: "meshobjects"}.Config(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProviderFactoriesForTest(func(provider *MeshStackProvider) {
+			provider.clientFactory = func(ctx context.Context, data MeshStackProviderModel, providerVersion string) (client.Client, diag.Diagnostics) {
+				return mockClient.AsClient(), nil
+			}
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config.String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meshstack_meshobjects.project_roles", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.meshstack_meshobjects.project_roles", "items.0.kind", "meshProjectRole"),
+					resource.TestCheckResourceAttr("data.meshstack_meshobjects.project_roles", "items.0.spec.restricted", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestJsonToAttrValue(t *testing.T) {
+	var diags diag.Diagnostics
+	object, ok := decodeMeshObjectJson(`{"name": "reader", "count": 2.5, "big": 9007199254740993, "ok": true, "none": null, "tags": ["a", 1]}`, path.Empty(), &diags)
+	require.True(t, ok)
+	value := jsonToAttrValue(object, &diags)
+	assert.False(t, diags.HasError())
+
+	expected, _ := types.ObjectValue(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"count": types.NumberType,
+			"big":   types.NumberType,
+			"ok":    types.BoolType,
+			"none":  types.StringType,
+			"tags":  types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}},
+		},
+		map[string]attr.Value{
+			"name":  types.StringValue("reader"),
+			"count": types.NumberValue(big.NewFloat(2.5)),
+			"big":   types.NumberValue(new(big.Float).SetInt64(9007199254740993)), // 2^53 + 1, not a float64
+			"ok":    types.BoolValue(true),
+			"none":  types.StringNull(),
+			"tags":  types.TupleValueMust([]attr.Type{types.StringType, types.NumberType}, []attr.Value{types.StringValue("a"), types.NumberValue(big.NewFloat(1))}),
+		},
+	)
+	assert.True(t, expected.Equal(value), "got %s", value)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &paymentMethodResource{}
 	_ resource.ResourceWithConfigure   = &paymentMethodResource{}
 	_ resource.ResourceWithImportState = &paymentMethodResource{}
+	_ resource.ResourceWithIdentity    = &paymentMethodResource{}
 )
 
 func NewPaymentMethodResource() resource.Resource {
@@ -104,6 +104,10 @@ func (r *paymentMethodResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *paymentMethodResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceScopedIdentitySchema("payment method")
+}
+
 func (r *paymentMethodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	paymentMethod := client.MeshPaymentMethodCreate{
 		Metadata: client.MeshPaymentMethodCreateMetadata{},
@@ -119,10 +123,7 @@ func (r *paymentMethodResource) Create(ctx context.Context, req resource.CreateR
 
 	createdPaymentMethod, err := r.meshPaymentMethodClient.Create(ctx, &paymentMethod)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Payment Method",
-			"Could not create payment method, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Creating Payment Method", err)
 		return
 	}
 
@@ -134,6 +135,7 @@ func (r *paymentMethodResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, createdPaymentMethod)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: createdPaymentMethod.Metadata.OwnedByWorkspace, Name: createdPaymentMethod.Metadata.Name})...)
 }
 
 func (r *paymentMethodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -172,6 +174,7 @@ func (r *paymentMethodResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, paymentMethod)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: paymentMethod.Metadata.OwnedByWorkspace, Name: paymentMethod.Metadata.Name})...)
 }
 
 func (r *paymentMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -190,10 +193,7 @@ func (r *paymentMethodResource) Update(ctx context.Context, req resource.UpdateR
 
 	updatedPaymentMethod, err := r.meshPaymentMethodClient.Update(ctx, paymentMethod.Metadata.Name, &paymentMethod)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Payment Method",
-			"Could not update payment method, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Updating Payment Method", err)
 		return
 	}
 
@@ -204,6 +204,7 @@ func (r *paymentMethodResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, updatedPaymentMethod)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: updatedPaymentMethod.Metadata.OwnedByWorkspace, Name: updatedPaymentMethod.Metadata.Name})...)
 }
 
 func (r *paymentMethodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -227,19 +228,11 @@ func (r *paymentMethodResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *paymentMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	identifier := strings.Split(req.ID, ".")
-
-	if len(identifier) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: workspace.payment-method-identifier Got: %q", req.ID),
-		)
+	identity := importIdentity(ctx, req, &resp.Diagnostics, parseWorkspaceScopedImportID("workspace.payment-method-identifier"))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	workspace := identifier[0]
-	paymentMethodName := identifier[1]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("owned_by_workspace"), workspace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), paymentMethodName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("owned_by_workspace"), identity.OwnedByWorkspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), identity.Name)...)
 }
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/types/generic"
+)
+
+var (
+	_ list.ListResource              = &platformListResource{}
+	_ list.ListResourceWithConfigure = &platformListResource{}
+)
+
+func NewPlatformListResource() list.ListResource {
+	return &platformListResource{}
+}
+
+type platformListResource struct {
+	meshPlatformClient client.MeshPlatformClient
+}
+
+func (r *platformListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform"
+}
+
+func (r *platformListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.meshPlatformClient = client.Platform
+	})...)
+}
+
+func (r *platformListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists platforms with optional filters.",
+		Attributes: map[string]schema.Attribute{
+			"owned_by_workspace": schema.StringAttribute{
+				MarkdownDescription: "Filter by the identifier of the workspace that owns the platform.",
+				Optional:            true,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Filter by platform identifier (`metadata.name`).",
+				Optional:            true,
+			},
+			"location_identifier": schema.StringAttribute{
+				MarkdownDescription: "Filter by location identifier.",
+				Optional:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Filter by display name.",
+				Optional:            true,
+			},
+			"restriction": schema.StringAttribute{
+				MarkdownDescription: "Filter by access restriction. One of: `PUBLIC`, `PRIVATE`, `RESTRICTED`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PUBLIC", "PRIVATE", "RESTRICTED"),
+				},
+			},
+			"publication_state": schema.StringAttribute{
+				MarkdownDescription: "Filter by marketplace publication state. One of: `PUBLISHED`, `UNPUBLISHED`, `REQUESTED`, `REJECTED`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PUBLISHED", "UNPUBLISHED", "REQUESTED", "REJECTED"),
+				},
+			},
+			"contributing_workspace": schema.StringAttribute{
+				MarkdownDescription: "Filter by a contributing workspace identifier.",
+				Optional:            true,
+			},
+			"platform_type_identifier": schema.StringAttribute{
+				MarkdownDescription: "Filter by platform type identifier (the platform type's `metadata.name`).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *platformListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var query client.MeshPlatformListQuery
+	var diags diag.Diagnostics
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("owned_by_workspace"), &query.OwnedByWorkspace)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("identifier"), &query.Identifier)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("location_identifier"), &query.LocationIdentifier)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("display_name"), &query.DisplayName)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("restriction"), &query.Restriction)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("publication_state"), &query.PublicationState)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("contributing_workspace"), &query.ContributingWorkspace)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("platform_type_identifier"), &query.PlatformTypeIdentifier)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.meshPlatformClient.All(ctx, query), "Unable to list platforms", func(platform client.MeshPlatform, result *list.ListResult) {
+		result.DisplayName = platform.Spec.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, uuidIdentity{Uuid: *platform.Metadata.Uuid})...)
+		if req.IncludeResource {
+			// Secrets are write-only and never returned, so the resource carries no secret values, as on import.
+			result.Diagnostics.Append(generic.Set(ctx, result.Resource, platformModelFromDto(&platform), platformConverterOptions(ctx, nil, nil, nil)...)...)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+func TestPlatformListResource(t *testing.T) {
+	ctx := context.Background()
+	mockClient := clientmock.NewMock()
+	uuids := map[string]string{}
+	for _, name := range []string{"platform-a", "platform-b"} {
+		platform, err := mockClient.Platform.Create(ctx, client.MeshPlatform{
+			Metadata: client.MeshPlatformMetadata{Name: name, OwnedByWorkspace: "my-workspace"},
+			Spec: client.MeshPlatformSpec{
+				DisplayName:  "Platform " + name,
+				LocationRef:  client.NamedRef{Name: "global", Kind: client.MeshObjectKind.Location},
+				Availability: client.PlatformAvailability{Restriction: "PUBLIC", PublicationState: "PUBLISHED"},
+				Config: &client.PlatformConfig{Type: "custom", Custom: &client.CustomPlatformConfig{
+					PlatformTypeRef: client.NamedRef{Name: "MY-PLATFORM-TYPE", Kind: client.MeshObjectKind.PlatformType},
+				}},
+			},
+		})
+		require.NoError(t, err)
+		uuids[name] = *platform.Metadata.Uuid
+	}
+	platformClient := &recordingPlatformClient{MeshPlatformClient: mockClient.Platform}
+	listResource := &platformListResource{meshPlatformClient: platformClient}
+
+	testListResource(t, NewPlatformResource(), listResource, &platformClient.query, func(t *testing.T, result list.ListResult) {
+		var identity uuidIdentity
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		name := listedAttribute(t, result, path.Root("metadata").AtName("name"))
+		assert.Equal(t, uuids[name], identity.Uuid)
+		assert.Equal(t, identity.Uuid, listedAttribute(t, result, path.Root("metadata").AtName("uuid")))
+	}, listResourceCase[client.MeshPlatformListQuery]{
+		name: "filters",
+		config: map[string]tftypes.Value{
+			"owned_by_workspace":       tftypes.NewValue(tftypes.String, "my-workspace"),
+			"identifier":               tftypes.NewValue(tftypes.String, "platform-a.global"),
+			"location_identifier":      tftypes.NewValue(tftypes.String, "global"),
+			"display_name":             tftypes.NewValue(tftypes.String, "Platform platform-a"),
+			"restriction":              tftypes.NewValue(tftypes.String, "PUBLIC"),
+			"publication_state":        tftypes.NewValue(tftypes.String, "PUBLISHED"),
+			"contributing_workspace":   tftypes.NewValue(tftypes.String, "contributing-workspace"),
+			"platform_type_identifier": tftypes.NewValue(tftypes.String, "MY-PLATFORM-TYPE"),
+		},
+		wantQuery: client.MeshPlatformListQuery{
+			OwnedByWorkspace:       new("my-workspace"),
+			Identifier:             new("platform-a.global"),
+			LocationIdentifier:     new("global"),
+			DisplayName:            new("Platform platform-a"),
+			Restriction:            new("PUBLIC"),
+			PublicationState:       new("PUBLISHED"),
+			ContributingWorkspace:  new("contributing-workspace"),
+			PlatformTypeIdentifier: new("MY-PLATFORM-TYPE"),
+		},
+	}, listResourceCase[client.MeshPlatformListQuery]{
+		name:             "include resource",
+		includeResource:  true,
+		wantDisplayNames: []string{"Platform platform-a", "Platform platform-b"},
+	})
+}
@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure   = &platformResource{}
 	_ resource.ResourceWithImportState = &platformResource{}
 	_ resource.ResourceWithModifyPlan  = &platformResource{}
+	_ resource.ResourceWithIdentity    = &platformResource{}
 )
 
 // NewPlatformResource is a helper function to simplify the provider implementation.
@@ -278,6 +279,10 @@ func platformConverterOptions(ctx context.Context, config, plan, state generic.A
 	)
 }
 
+func (r *platformResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("platform")
+}
+
 func (r *platformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	converterOptions := platformConverterOptions(ctx, req.Config, req.Plan, nil)
 	model := generic.Get[platformModel](ctx, req.Plan, &resp.Diagnostics, converterOptions.Append(generic.WithSetUnknownValueToZero())...)
@@ -286,10 +291,11 @@ func (r *platformResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	createdPlatform, err := r.meshPlatformClient.Create(ctx, client.MeshPlatform{Metadata: model.Metadata, Spec: model.Spec})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Creating Platform", "Could not create platform, unexpected error: "+err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Creating Platform", err)
 		return
 	}
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, platformModelFromDto(createdPlatform), converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *createdPlatform.Metadata.Uuid})...)
 }
 
 func (r *platformResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -311,6 +317,7 @@ func (r *platformResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, platformModelFromDto(readPlatform), platformConverterOptions(ctx, nil, nil, req.State)...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *readPlatform.Metadata.Uuid})...)
 }
 
 func (r *platformResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -330,10 +337,11 @@ func (r *platformResource) Update(ctx context.Context, req resource.UpdateReques
 
 	updatedPlatform, err := r.meshPlatformClient.Update(ctx, *model.Metadata.Uuid, client.MeshPlatform{Metadata: model.Metadata, Spec: model.Spec})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Platform", "Could not update platform, unexpected error: "+err.Error())
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Updating Platform", err)
 		return
 	}
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, platformModelFromDto(updatedPlatform), converterOptions...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: *updatedPlatform.Metadata.Uuid})...)
 }
 
 func (r *platformResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -354,5 +362,5 @@ func (r *platformResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *platformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("uuid"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("uuid"), path.Root("uuid"), req, resp)
 }
@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &platformTypeResource{}
 	_ resource.ResourceWithConfigure   = &platformTypeResource{}
 	_ resource.ResourceWithImportState = &platformTypeResource{}
+	_ resource.ResourceWithIdentity    = &platformTypeResource{}
 )
 
 func NewPlatformTypeResource() resource.Resource {
@@ -130,6 +131,10 @@ func (r *platformTypeResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *platformTypeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("platform type")
+}
+
 func (r *platformTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var name string
 	var ownedByWorkspace string
@@ -164,14 +169,12 @@ func (r *platformTypeResource) Create(ctx context.Context, req resource.CreateRe
 
 	createdPlatformType, err := r.meshPlatformTypeClient.Create(ctx, &platformType)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Platform Type",
-			"Could not create platform type, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Creating Platform Type", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newPlatformTypeModel(createdPlatformType))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: createdPlatformType.Metadata.Name})...)
 }
 
 func (r *platformTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -197,6 +200,7 @@ func (r *platformTypeResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newPlatformTypeModel(platformType))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: platformType.Metadata.Name})...)
 }
 
 func (r *platformTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -235,14 +239,12 @@ func (r *platformTypeResource) Update(ctx context.Context, req resource.UpdateRe
 
 	updatedPlatformType, err := r.meshPlatformTypeClient.Update(ctx, stateName, &platformType)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Platform Type",
-			"Could not update platform type, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Updating Platform Type", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newPlatformTypeModel(updatedPlatformType))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: updatedPlatformType.Metadata.Name})...)
 }
 
 func (r *platformTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -264,5 +266,5 @@ func (r *platformTypeResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *platformTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("name"), path.Root("name"), req, resp)
 }
@@ -19,7 +19,8 @@ func TestAccPlatformsDataSource(t *testing.T) {
 	t.Parallel()
 
 	// plain listing creates a platform in a fresh workspace and lists it back, running identically in
-	// mock and acceptance mode. Filtering by the fresh workspace yields exactly one platform.
+	// mock and acceptance mode. Filtering by the fresh workspace yields exactly one platform. In mock mode
+	// it goes through the mock server, so that the filter is sent as a query param like to meshStack.
 	t.Run("plain listing", func(t *testing.T) {
 		platformConfig, platformAddr, workspaceAddr := testconfig.CustomPlatformAndWorkspace(t)
 
@@ -43,7 +44,7 @@ func TestAccPlatformsDataSource(t *testing.T) {
 					},
 				},
 			},
-		})
+		}, ThroughMockServer())
 	})
 
 	// cross-workspace listing proves a consumer workspace's restricted key can list a platform published
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &projectGroupBindingResource{}
 	_ resource.ResourceWithConfigure   = &projectGroupBindingResource{}
 	_ resource.ResourceWithImportState = &projectGroupBindingResource{}
+	_ resource.ResourceWithIdentity    = &projectGroupBindingResource{}
 )
 
 // NewProjectGroupBindingResource is a helper function to simplify the provider implementation.
@@ -107,6 +108,10 @@ func (r *projectGroupBindingResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *projectGroupBindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectBindingIdentitySchema("project group binding")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectGroupBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan client.MeshProjectGroupBinding
@@ -119,15 +124,13 @@ func (r *projectGroupBindingResource) Create(ctx context.Context, req resource.C
 
 	binding, err := r.meshProjectGroupBindingClient.Create(ctx, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating project group binding",
-			"Could not create project group binding, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating project group binding", err)
 		return
 	}
 
 	diags = resp.State.Set(ctx, binding)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newProjectBindingIdentity(binding.MeshProjectBinding))...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -149,6 +152,7 @@ func (r *projectGroupBindingResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, binding)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newProjectBindingIdentity(binding.MeshProjectBinding))...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -175,5 +179,16 @@ func (r *projectGroupBindingResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *projectGroupBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), req.ID)...)
+	name := importBindingName(ctx, req, &resp.Diagnostics, "project group binding", func(name string) (*projectBindingIdentity, error) {
+		binding, err := r.meshProjectGroupBindingClient.Read(ctx, name)
+		if binding == nil {
+			return nil, err
+		}
+		return new(newProjectBindingIdentity(binding.MeshProjectBinding)), err
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), name)...)
 }
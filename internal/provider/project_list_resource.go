@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ list.ListResource              = &projectListResource{}
+	_ list.ListResourceWithConfigure = &projectListResource{}
+)
+
+func NewProjectListResource() list.ListResource {
+	return &projectListResource{}
+}
+
+type projectListResource struct {
+	meshProjectClient client.MeshProjectClient
+}
+
+func (r *projectListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *projectListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.meshProjectClient = client.Project
+	})...)
+}
+
+func (r *projectListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the projects of a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_identifier": schema.StringAttribute{
+				MarkdownDescription: "Workspace identifier",
+				Required:            true,
+			},
+			"payment_method_identifier": schema.StringAttribute{
+				MarkdownDescription: "Payment method identifier",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *projectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var workspaceIdentifier string
+	var paymentMethodIdentifier *string
+	var diags diag.Diagnostics
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("workspace_identifier"), &workspaceIdentifier)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("payment_method_identifier"), &paymentMethodIdentifier)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.meshProjectClient.All(ctx, workspaceIdentifier, paymentMethodIdentifier), "Unable to read projects", func(project client.MeshProject, result *list.ListResult) {
+		result.DisplayName = project.Spec.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: project.Metadata.OwnedByWorkspace, Name: project.Metadata.Name})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, project)...)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+func TestProjectListResource(t *testing.T) {
+	ctx := context.Background()
+	mockClient := clientmock.NewMock()
+	for _, project := range []struct{ workspace, name string }{{"my-workspace", "project-a"}, {"my-workspace", "project-b"}, {"other-workspace", "project-c"}} {
+		_, err := mockClient.Project.Create(ctx, &client.MeshProjectCreate{
+			Metadata: client.MeshProjectCreateMetadata{Name: project.name, OwnedByWorkspace: project.workspace},
+			Spec:     client.MeshProjectSpec{DisplayName: "Project " + project.name},
+		})
+		require.NoError(t, err)
+	}
+	projectClient := &recordingProjectClient{MeshProjectClient: mockClient.Project}
+	listResource := &projectListResource{meshProjectClient: projectClient}
+
+	testListResource(t, NewProjectResource(), listResource, &projectClient.query, func(t *testing.T, result list.ListResult) {
+		var identity workspaceScopedIdentity
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		assert.Equal(t, identity.Name, listedAttribute(t, result, path.Root("metadata").AtName("name")))
+		assert.Equal(t, identity.OwnedByWorkspace, listedAttribute(t, result, path.Root("metadata").AtName("owned_by_workspace")))
+	}, listResourceCase[recordedProjectQuery]{
+		name: "filters",
+		config: map[string]tftypes.Value{
+			"workspace_identifier":      tftypes.NewValue(tftypes.String, "my-workspace"),
+			"payment_method_identifier": tftypes.NewValue(tftypes.String, "my-payment-method"),
+		},
+		wantQuery: recordedProjectQuery{workspaceIdentifier: "my-workspace", paymentMethodIdentifier: new("my-payment-method")},
+		// The mock does not filter by payment method.
+		wantDisplayNames: []string{"Project project-a", "Project project-b"},
+	}, listResourceCase[recordedProjectQuery]{
+		name:             "include resource",
+		config:           map[string]tftypes.Value{"workspace_identifier": tftypes.NewValue(tftypes.String, "my-workspace")},
+		includeResource:  true,
+		wantQuery:        recordedProjectQuery{workspaceIdentifier: "my-workspace"},
+		wantDisplayNames: []string{"Project project-a", "Project project-b"},
+	})
+}
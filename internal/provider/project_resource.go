@@ -2,8 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,6 +18,7 @@ var (
 	_ resource.Resource                = &projectResource{}
 	_ resource.ResourceWithConfigure   = &projectResource{}
 	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.ResourceWithIdentity    = &projectResource{}
 )
 
 // NewProjectResource is a helper function to simplify the provider implementation.
@@ -112,6 +111,10 @@ type projectSpec struct {
 	SubstitutePaymentMethodIdentifier types.String `json:"substitutePaymentMethodIdentifier" tfsdk:"substitute_payment_method_identifier"`
 }
 
+func (r *projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceScopedIdentitySchema("project")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectCreate
@@ -157,10 +160,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	project, err := r.meshProjectClient.Create(ctx, &create)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating project",
-			"Could not create project, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating project", err)
 		return
 	}
 
@@ -168,6 +168,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, project)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: project.Metadata.OwnedByWorkspace, Name: project.Metadata.Name})...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -202,6 +203,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// client data maps directly to the schema so we just need to set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, project)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: project.Metadata.OwnedByWorkspace, Name: project.Metadata.Name})...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -249,10 +251,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	project, err := r.meshProjectClient.Update(ctx, &create)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating project",
-			"Could not update project, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating project", err)
 		return
 	}
 
@@ -260,6 +259,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	diags = resp.State.Set(ctx, project)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceScopedIdentity{OwnedByWorkspace: project.Metadata.OwnedByWorkspace, Name: project.Metadata.Name})...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -283,16 +283,11 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	identifier := strings.Split(req.ID, ".")
-
-	if len(identifier) != 2 || identifier[0] == "" || identifier[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: workspace.project. Got: %q", req.ID),
-		)
+	identity := importIdentity(ctx, req, &resp.Diagnostics, parseWorkspaceScopedImportID("workspace.project"))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("owned_by_workspace"), identifier[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), identifier[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("owned_by_workspace"), identity.OwnedByWorkspace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), identity.Name)...)
 }
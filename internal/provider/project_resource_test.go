@@ -70,6 +70,10 @@ func TestAccProject(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceAddress.String(), tfjsonpath.New("metadata").AtMapKey("name"), xknownvalue.NotEmptyString()),
 					statecheck.ExpectKnownValue(resourceAddress.String(), tfjsonpath.New("metadata").AtMapKey("owned_by_workspace"), xknownvalue.NotEmptyString()),
 					statecheck.ExpectKnownValue(resourceAddress.String(), tfjsonpath.New("spec").AtMapKey("display_name"), knownvalue.StringExact("My Project's Display Name")),
+					statecheck.ExpectIdentity(resourceAddress.String(), map[string]knownvalue.Check{
+						"owned_by_workspace": xknownvalue.NotEmptyString(),
+						"name":               xknownvalue.NotEmptyString(),
+					}),
 				},
 			},
 			{
@@ -99,6 +103,11 @@ func TestAccProject(t *testing.T) {
 					return ws.Primary.Attributes["metadata.name"] + "." + rs.Primary.Attributes["metadata.name"], nil
 				},
 			},
+			{
+				ResourceName:    resourceAddress.String(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 		},
 	})
 }
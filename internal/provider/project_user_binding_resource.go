@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &projectUserBindingResource{}
 	_ resource.ResourceWithConfigure   = &projectUserBindingResource{}
 	_ resource.ResourceWithImportState = &projectUserBindingResource{}
+	_ resource.ResourceWithIdentity    = &projectUserBindingResource{}
 )
 
 // NewProjectUserBindingResource is a helper function to simplify the provider implementation.
@@ -107,6 +108,10 @@ func (r *projectUserBindingResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *projectUserBindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectBindingIdentitySchema("project user binding")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectUserBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan client.MeshProjectUserBinding
@@ -119,15 +124,13 @@ func (r *projectUserBindingResource) Create(ctx context.Context, req resource.Cr
 
 	binding, err := r.meshProjectUserBindingClient.Create(ctx, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating project user binding",
-			"Could not create project user binding, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating project user binding", err)
 		return
 	}
 
 	diags = resp.State.Set(ctx, binding)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newProjectBindingIdentity(binding.MeshProjectBinding))...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -149,6 +152,7 @@ func (r *projectUserBindingResource) Read(ctx context.Context, req resource.Read
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, binding)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newProjectBindingIdentity(binding.MeshProjectBinding))...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -175,5 +179,16 @@ func (r *projectUserBindingResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *projectUserBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), req.ID)...)
+	name := importBindingName(ctx, req, &resp.Diagnostics, "project user binding", func(name string) (*projectBindingIdentity, error) {
+		binding, err := r.meshProjectUserBindingClient.Read(ctx, name)
+		if binding == nil {
+			return nil, err
+		}
+		return new(newProjectBindingIdentity(binding.MeshProjectBinding)), err
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), name)...)
 }
@@ -43,6 +43,11 @@ func TestAccProjectUserBinding(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceAddress.String(), tfjsonpath.New("role_ref").AtMapKey("name"), knownvalue.StringExact("Project Reader")),
 					statecheck.ExpectKnownValue(resourceAddress.String(), tfjsonpath.New("target_ref").AtMapKey("name"), xknownvalue.NotEmptyString()),
 					statecheck.ExpectKnownValue(resourceAddress.String(), tfjsonpath.New("subject").AtMapKey("name"), knownvalue.StringExact("user@meshcloud.io")),
+					statecheck.ExpectIdentity(resourceAddress.String(), map[string]knownvalue.Check{
+						"workspace": xknownvalue.NotEmptyString(),
+						"project":   xknownvalue.NotEmptyString(),
+						"name":      knownvalue.StringExact("this-is-an-example"),
+					}),
 				},
 			},
 			{
@@ -51,6 +56,11 @@ func TestAccProjectUserBinding(t *testing.T) {
 				ImportStateId:   "this-is-an-example",
 				ImportStateKind: resource.ImportBlockWithID,
 			},
+			{
+				ResourceName:    resourceAddress.String(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 		},
 	})
 }
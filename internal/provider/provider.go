@@ -5,21 +5,37 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
 	"github.com/meshcloud/terraform-provider-meshstack/internal/util/logging"
 )
 
 // Ensure MeshStackProvider satisfies various provider interfaces.
-var _ provider.ProviderWithFunctions = &MeshStackProvider{}
+var (
+	_ provider.ProviderWithFunctions          = &MeshStackProvider{}
+	_ provider.ProviderWithEphemeralResources = &MeshStackProvider{}
+	_ provider.ProviderWithListResources      = &MeshStackProvider{}
+	_ provider.ProviderWithActions            = &MeshStackProvider{}
+)
 
 type MeshStackProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -32,10 +48,37 @@ type MeshStackProvider struct {
 }
 
 type MeshStackProviderModel struct {
-	Endpoint  types.String `tfsdk:"endpoint"`
-	ApiKey    types.String `tfsdk:"apikey"`
-	ApiSecret types.String `tfsdk:"apisecret"`
-	ApiToken  types.String `tfsdk:"apitoken"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	ApiKey     types.String `tfsdk:"apikey"`
+	ApiSecret  types.String `tfsdk:"apisecret"`
+	ApiToken   types.String `tfsdk:"apitoken"`
+	TokenCache types.Bool   `tfsdk:"token_cache"`
+
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	ApiVersions types.Map `tfsdk:"api_versions"`
+
+	Oidc *MeshStackProviderOidcModel `tfsdk:"oidc"`
+	Mock *MeshStackProviderMockModel `tfsdk:"mock"`
+}
+
+type MeshStackProviderOidcModel struct {
+	TokenFile types.String `tfsdk:"token_file"`
+	Audience  types.String `tfsdk:"audience"`
+	ClientId  types.String `tfsdk:"client_id"`
+}
+
+type MeshStackProviderMockModel struct {
+	SeedFile  types.String `tfsdk:"seed_file"`
+	StateFile types.String `tfsdk:"state_file"`
 }
 
 func (p *MeshStackProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,7 +90,7 @@ func (p *MeshStackProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "URl of meshStack API, e.g. `https://api.my.meshstack.io`",
+				MarkdownDescription: "URl of meshStack API, e.g. `https://api.my.meshstack.io`, or `mock://` for an in-memory meshStack, see `mock`.",
 				Optional:            true,
 			},
 			"apikey": schema.StringAttribute{
@@ -64,15 +107,130 @@ func (p *MeshStackProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_cache": schema.BoolAttribute{
+				MarkdownDescription: "Cache the access token obtained with `apikey` and `apisecret` in the user's cache directory, " +
+					"so that the provider processes started during a Terraform run share one login instead of each logging in again.",
+				Optional: true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to the meshStack API, allowing short bursts of up to one second's worth of requests. " +
+					"Unlimited if not set.",
+				Optional:   true,
+				Validators: []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests to the meshStack API in flight at the same time, independent of Terraform's `-parallelism`. " +
+					"Unlimited if not set.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates to trust in addition to the system's, e.g. of a TLS-intercepting corporate proxy. " +
+					"Conflicts with `ca_cert_file`.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file"))},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file with PEM-encoded CA certificates to trust in addition to the system's. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate presented to meshStack (mutual TLS). Requires `client_key`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("client_key"))},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("client_cert"))},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send all requests through, e.g. `http://proxy.example.com:3128`. " +
+					"If not set, the `HTTPS_PROXY`/`HTTP_PROXY` and `NO_PROXY` environment variables apply.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of meshStack's TLS certificate. **For development only**, prefer `ca_cert_pem` or `ca_cert_file`.",
+				Optional:            true,
+			},
+			"api_versions": schema.MapAttribute{
+				MarkdownDescription: "API versions to use by meshObject kind, e.g. `{ meshBuildingBlock = \"v2-preview\" }`, to pin a version instead of using " +
+					"the newest one supported by both the provider and meshStack. Can be sourced from `MESHSTACK_API_VERSIONS` as comma-separated " +
+					"`kind=version` pairs, e.g. `meshBuildingBlock=v2-preview,meshApiKey=v1-preview`. A version the provider was not checked against, " +
+					"e.g. the GA version of a preview API, is used with a warning, so that you can opt into it before the provider supports it.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oidc": schema.SingleNestedBlock{
+				MarkdownDescription: "Authenticate with a federated workload-identity JWT (e.g. from GitHub Actions or GitLab CI) instead of an API key. " +
+					"The JWT is exchanged for a short-lived meshStack access token, which is renewed before it expires.",
+				Attributes: map[string]schema.Attribute{
+					"token_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a file containing the JWT. The file is read again on every renewal, so it may be rotated. " +
+							"If not set, the JWT is read from the `MESHSTACK_OIDC_TOKEN` environment variable.",
+						Optional: true,
+					},
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "Audience the JWT was issued for, verified by meshStack.",
+						Optional:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client ID of the meshStack workload identity the JWT is exchanged for.",
+						Optional:            true,
+					},
+				},
+			},
+			"mock": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures the in-memory meshStack used with `endpoint = \"mock://\"`, e.g. for workshops or `terraform test` without a meshStack. " +
+					"It simulates the meshStack API without any authentication, and only roughly: building blocks succeed right away, and permissions are not checked.",
+				Attributes: map[string]schema.Attribute{
+					"seed_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a JSON file with the `workspaces`, `platforms` and `buildingBlockDefinitions` a new in-memory meshStack starts with, " +
+							"each a list of objects as sent to the meshStack API.",
+						Optional: true,
+					},
+					"state_file": schema.StringAttribute{
+						MarkdownDescription: "Path to the file the in-memory meshStack is kept in between Terraform runs. Defaults to `.meshstack-mock.json`. " +
+							"Delete it to start over from `seed_file`.",
+						Optional: true,
+					},
+				},
+			},
 		},
 	}
 }
 
 const (
-	envKeyMeshstackEndpoint  = "MESHSTACK_ENDPOINT"
-	envKeyMeshstackApiKey    = "MESHSTACK_API_KEY"
-	envKeyMeshstackApiSecret = "MESHSTACK_API_SECRET"
-	envKeyMeshstackApiToken  = "MESHSTACK_API_TOKEN"
+	envKeyMeshstackEndpoint   = "MESHSTACK_ENDPOINT"
+	envKeyMeshstackApiKey     = "MESHSTACK_API_KEY"
+	envKeyMeshstackApiSecret  = "MESHSTACK_API_SECRET"
+	envKeyMeshstackApiToken   = "MESHSTACK_API_TOKEN"
+	envKeyMeshstackTokenCache = "MESHSTACK_TOKEN_CACHE"
+
+	envKeyMeshstackMaxRequestsPerSecond  = "MESHSTACK_MAX_REQUESTS_PER_SECOND"
+	envKeyMeshstackMaxConcurrentRequests = "MESHSTACK_MAX_CONCURRENT_REQUESTS"
+
+	envKeyMeshstackCaCertPem          = "MESHSTACK_CA_CERT_PEM"
+	envKeyMeshstackCaCertFile         = "MESHSTACK_CA_CERT_FILE"
+	envKeyMeshstackClientCert         = "MESHSTACK_CLIENT_CERT"
+	envKeyMeshstackClientKey          = "MESHSTACK_CLIENT_KEY"
+	envKeyMeshstackProxyUrl           = "MESHSTACK_PROXY_URL"
+	envKeyMeshstackInsecureSkipVerify = "MESHSTACK_INSECURE_SKIP_VERIFY"
+
+	envKeyMeshstackApiVersions = "MESHSTACK_API_VERSIONS"
+
+	envKeyMeshstackOidcToken     = "MESHSTACK_OIDC_TOKEN"
+	envKeyMeshstackOidcTokenFile = "MESHSTACK_OIDC_TOKEN_FILE"
+	envKeyMeshstackOidcAudience  = "MESHSTACK_OIDC_AUDIENCE"
+	envKeyMeshstackOidcClientId  = "MESHSTACK_OIDC_CLIENT_ID"
+
+	envKeyMeshstackMockSeedFile  = "MESHSTACK_MOCK_SEED_FILE"
+	envKeyMeshstackMockStateFile = "MESHSTACK_MOCK_STATE_FILE"
+
+	defaultMockStateFile = ".meshstack-mock.json"
 )
 
 func (p *MeshStackProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -83,6 +241,9 @@ func (p *MeshStackProvider) Configure(ctx context.Context, req provider.Configur
 	resp.Diagnostics.Append(diags...)
 	resp.DataSourceData = providerClient
 	resp.ResourceData = providerClient
+	resp.EphemeralResourceData = providerClient
+	resp.ListResourceData = providerClient
+	resp.ActionData = providerClient
 }
 
 func configureProviderClient(providerData any, consumer func(client client.Client)) (diags diag.Diagnostics) {
@@ -119,6 +280,9 @@ func newProviderClient(ctx context.Context, data MeshStackProviderModel, provide
 		diags.AddError("Provider endpoint not valid.", "The value provided as the providers endpoint is not a valid URL.")
 		return
 	}
+	if parsedEndpoint.Scheme == "mock" {
+		return newMockProviderClient(ctx, data.Mock)
+	}
 
 	var apiToken string
 	if !data.ApiToken.IsNull() && !data.ApiToken.IsUnknown() {
@@ -141,9 +305,17 @@ func newProviderClient(ctx context.Context, data MeshStackProviderModel, provide
 		apiSecret = os.Getenv(envKeyMeshstackApiSecret)
 	}
 
-	// Either apiToken or apiKey/apiSecret must be set for authorization against backend.
+	// Either apiToken, OIDC or apiKey/apiSecret must be set for authorization against backend.
 	var auth client.Authorization
-	if apiToken == "" {
+	if apiToken != "" {
+		auth = client.NewApiTokenAuthorization(apiToken)
+	} else {
+		auth, diags = newOidcAuthorization(data.Oidc)
+		if diags.HasError() {
+			return
+		}
+	}
+	if auth == nil {
 		if apiKey == "" {
 			diags.AddError("Provider API key missing.", "Set provider.meshstack.apikey or use MESHSTACK_API_KEY environment variable.")
 		}
@@ -153,13 +325,61 @@ func newProviderClient(ctx context.Context, data MeshStackProviderModel, provide
 		if diags.HasError() {
 			return
 		}
-		auth = client.NewApiKeyAuthorization(apiKey, apiSecret)
+		var tokenCache bool
+		if !data.TokenCache.IsNull() && !data.TokenCache.IsUnknown() {
+			tokenCache = data.TokenCache.ValueBool()
+		} else {
+			tokenCache = os.Getenv(envKeyMeshstackTokenCache) == "true"
+		}
+		if tokenCache {
+			if auth, err = client.NewCachedApiKeyAuthorization(apiKey, apiSecret); err != nil {
+				diags.AddError("Provider token cache not available.", err.Error())
+				return
+			}
+		} else {
+			auth = client.NewApiKeyAuthorization(apiKey, apiSecret)
+		}
+	}
+
+	var clientOptions []client.Option
+	maxRequestsPerSecond, maxConcurrentRequests := data.MaxRequestsPerSecond.ValueFloat64(), data.MaxConcurrentRequests.ValueInt64()
+	if data.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = envOrDefault(&diags, envKeyMeshstackMaxRequestsPerSecond, 0.0, func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		})
+	}
+	if data.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = envOrDefault(&diags, envKeyMeshstackMaxConcurrentRequests, 0, func(s string) (int64, error) {
+			return strconv.ParseInt(s, 10, 64)
+		})
+	}
+	if diags.HasError() {
+		return
+	}
+	clientOptions = append(clientOptions, client.WithRateLimit(maxRequestsPerSecond, int(maxConcurrentRequests)))
+
+	transportOptions, transportDiags := newTransportOptions(data)
+	diags.Append(transportDiags...)
+	if diags.HasError() {
+		return
+	}
+	clientOptions = append(clientOptions, transportOptions...)
+
+	apiVersions := map[string]string{}
+	if !data.ApiVersions.IsNull() {
+		diags.Append(data.ApiVersions.ElementsAs(ctx, &apiVersions, false)...)
 	} else {
-		auth = client.NewApiTokenAuthorization(apiToken)
+		apiVersions = envOrDefault(&diags, envKeyMeshstackApiVersions, apiVersions, parseApiVersions)
+	}
+	if diags.HasError() {
+		return
+	}
+	if len(apiVersions) > 0 {
+		clientOptions = append(clientOptions, client.WithApiVersions(apiVersions))
 	}
 
 	userAgent := fmt.Sprintf("terraform-provider-meshstack/%s", providerVersion)
-	providerClient, err = client.New(ctx, parsedEndpoint, userAgent, auth)
+	providerClient, err = client.New(ctx, parsedEndpoint, userAgent, auth, clientOptions...)
 	if err != nil {
 		diags.AddError("Failed to create meshStack client.", err.Error())
 		return
@@ -167,6 +387,146 @@ func newProviderClient(ctx context.Context, data MeshStackProviderModel, provide
 	return
 }
 
+// envOrDefault parses the environment variable with the given key, returning defaultValue if it is unset or empty.
+func envOrDefault[T any](diags *diag.Diagnostics, key string, defaultValue T, parse func(string) (T, error)) T {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := parse(value)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid %s environment variable.", key), err.Error())
+		return defaultValue
+	}
+	return parsed
+}
+
+// newTransportOptions configures the TLS trust, client certificate and proxy of the client.
+func newTransportOptions(data MeshStackProviderModel) (opts []client.Option, diags diag.Diagnostics) {
+	caCertPem := stringValueOrEnv(data.CaCertPem, envKeyMeshstackCaCertPem)
+	caCertFile := stringValueOrEnv(data.CaCertFile, envKeyMeshstackCaCertFile)
+	if caCertPem != "" && caCertFile != "" {
+		diags.AddError("Provider CA certificates configured twice.", "Set either provider.meshstack.ca_cert_pem (MESHSTACK_CA_CERT_PEM) or provider.meshstack.ca_cert_file (MESHSTACK_CA_CERT_FILE), not both.")
+		return
+	}
+	if caCertFile != "" {
+		content, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddError("Provider CA certificates file not readable.", err.Error())
+			return
+		}
+		caCertPem = string(content)
+	}
+	if caCertPem != "" {
+		opts = append(opts, client.WithCaCertificates([]byte(caCertPem)))
+	}
+
+	clientCert := stringValueOrEnv(data.ClientCert, envKeyMeshstackClientCert)
+	clientKey := stringValueOrEnv(data.ClientKey, envKeyMeshstackClientKey)
+	if (clientCert == "") != (clientKey == "") {
+		diags.AddError("Provider client certificate incomplete.", "Set both provider.meshstack.client_cert (MESHSTACK_CLIENT_CERT) and provider.meshstack.client_key (MESHSTACK_CLIENT_KEY).")
+		return
+	}
+	if clientCert != "" {
+		opts = append(opts, client.WithClientCertificate([]byte(clientCert), []byte(clientKey)))
+	}
+
+	if proxyUrl := stringValueOrEnv(data.ProxyUrl, envKeyMeshstackProxyUrl); proxyUrl != "" {
+		parsedProxyUrl, err := url.Parse(proxyUrl)
+		if err != nil || parsedProxyUrl.Host == "" {
+			diags.AddError("Provider proxy URL not valid.", fmt.Sprintf("The value '%s' provided as the providers proxy_url is not a valid URL.", proxyUrl))
+			return
+		}
+		opts = append(opts, client.WithProxy(parsedProxyUrl))
+	}
+
+	insecureSkipVerify := data.InsecureSkipVerify.ValueBool()
+	if data.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = envOrDefault(&diags, envKeyMeshstackInsecureSkipVerify, false, strconv.ParseBool)
+	}
+	if insecureSkipVerify {
+		opts = append(opts, client.WithInsecureSkipVerify())
+	}
+	return
+}
+
+// parseApiVersions parses comma-separated kind=version pairs, e.g. "meshBuildingBlock=v2-preview,meshTenant=v4".
+func parseApiVersions(s string) (map[string]string, error) {
+	apiVersions := map[string]string{}
+	for pair := range strings.SplitSeq(s, ",") {
+		kind, version, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || kind == "" || version == "" {
+			return nil, fmt.Errorf("expected comma-separated kind=version pairs, got '%s'", pair)
+		}
+		apiVersions[kind] = version
+	}
+	return apiVersions, nil
+}
+
+// stringValueOrEnv returns the configured value, falling back to the environment variable with the given key.
+func stringValueOrEnv(value types.String, envKey string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envKey)
+}
+
+// newOidcAuthorization returns nil if OIDC is configured neither by the oidc block nor by the
+// MESHSTACK_OIDC_TOKEN or MESHSTACK_OIDC_TOKEN_FILE environment variables.
+func newOidcAuthorization(data *MeshStackProviderOidcModel) (auth client.Authorization, diags diag.Diagnostics) {
+	blockConfigured := data != nil
+	if !blockConfigured {
+		data = &MeshStackProviderOidcModel{}
+	}
+	tokenFile := stringValueOrEnv(data.TokenFile, envKeyMeshstackOidcTokenFile)
+	_, hasTokenEnv := os.LookupEnv(envKeyMeshstackOidcToken)
+	if !blockConfigured && tokenFile == "" && !hasTokenEnv {
+		return
+	}
+
+	clientId := stringValueOrEnv(data.ClientId, envKeyMeshstackOidcClientId)
+	if clientId == "" {
+		diags.AddError("Provider OIDC client ID missing.", "Set provider.meshstack.oidc.client_id or use MESHSTACK_OIDC_CLIENT_ID environment variable.")
+		return
+	}
+
+	var tokenSource client.OidcTokenSource
+	if tokenFile != "" {
+		tokenSource = client.OidcTokenFromFile(tokenFile)
+	} else if hasTokenEnv {
+		tokenSource = client.OidcTokenFromEnv(envKeyMeshstackOidcToken)
+	} else {
+		diags.AddError("Provider OIDC token missing.", "Set provider.meshstack.oidc.token_file or use MESHSTACK_OIDC_TOKEN_FILE or MESHSTACK_OIDC_TOKEN environment variable.")
+		return
+	}
+	return client.NewOidcAuthorization(clientId, stringValueOrEnv(data.Audience, envKeyMeshstackOidcAudience), tokenSource), diags
+}
+
+// newMockProviderClient returns the in-memory meshStack of endpoint mock://, kept in the state file between runs.
+func newMockProviderClient(ctx context.Context, data *MeshStackProviderMockModel) (providerClient client.Client, diags diag.Diagnostics) {
+	if data == nil {
+		data = &MeshStackProviderMockModel{}
+	}
+	var seed clientmock.Seed
+	if seedFile := stringValueOrEnv(data.SeedFile, envKeyMeshstackMockSeedFile); seedFile != "" {
+		var err error
+		if seed, err = clientmock.ReadSeed(seedFile); err != nil {
+			diags.AddError("Provider mock seed file not readable.", err.Error())
+			return
+		}
+	}
+	stateFile := stringValueOrEnv(data.StateFile, envKeyMeshstackMockStateFile)
+	if stateFile == "" {
+		stateFile = defaultMockStateFile
+	}
+	mock, err := clientmock.NewOffline(ctx, stateFile, seed)
+	if err != nil {
+		diags.AddError("Provider mock state file not usable.", err.Error())
+		return
+	}
+	return mock.AsClient(), diags
+}
+
 func (p *MeshStackProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewProjectResource,
@@ -191,6 +551,7 @@ func (p *MeshStackProvider) Resources(_ context.Context) []func() resource.Resou
 		NewIntegrationResource,
 		NewBuildingBlockRunnerResource,
 		NewApiKeyResource,
+		NewMeshObjectResource,
 	}
 }
 
@@ -221,6 +582,30 @@ func (p *MeshStackProvider) DataSources(_ context.Context) []func() datasource.D
 		NewPlatformTypeDataSource,
 		NewServiceInstanceDataSource,
 		NewServiceInstancesDataSource,
+		NewMeshObjectsDataSource,
+	}
+}
+
+func (p *MeshStackProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *MeshStackProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewWorkspaceListResource,
+		NewProjectListResource,
+		NewTenantListResource,
+		NewBuildingBlockListResource,
+		NewLandingZoneListResource,
+		NewPlatformListResource,
+	}
+}
+
+func (p *MeshStackProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewBuildingBlockRunAction,
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -90,6 +91,16 @@ type ApplyAndTestOption func(*applyAndTestOptions)
 
 type applyAndTestOptions struct {
 	LockExclusiveKinds []string
+	ThroughMockServer  bool
+}
+
+// ThroughMockServer makes a test in mock client mode run the real client against clientmock.NewServer,
+// instead of handing the mock client to the provider. This covers what only a real meshStack would
+// otherwise, e.g. that the filters of a list reach the mock as query params. It has no effect with TF_ACC.
+func ThroughMockServer() ApplyAndTestOption {
+	return func(options *applyAndTestOptions) {
+		options.ThroughMockServer = true
+	}
 }
 
 // TouchesExclusively marks a test that creates a restricted tag definition with a default value for
@@ -162,6 +173,17 @@ func ApplyAndTest(t *testing.T, testCase resource.TestCase, opts ...ApplyAndTest
 				return mockClient.AsClient(), nil
 			}
 		})
+		if options.ThroughMockServer {
+			server := clientmock.NewServer(mockClient)
+			t.Cleanup(server.Close)
+			testCase.ProtoV6ProviderFactories = ProviderFactoriesForTest(func(provider *MeshStackProvider) {
+				provider.clientFactory = func(ctx context.Context, data MeshStackProviderModel, providerVersion string) (client.Client, diag.Diagnostics) {
+					data.Endpoint = types.StringValue(server.URL)
+					data.ApiKey, data.ApiSecret = types.StringValue(server.ApiKey), types.StringValue(server.ApiSecret)
+					return newProviderClient(ctx, data, providerVersion)
+				}
+			})
+		}
 	} else {
 		// os.Setenv (not t.Setenv) because t.Setenv is incompatible with the t.Parallel() call below.
 		require.NoError(t, os.Setenv("MESHSTACK_SKIP_VERSION_CHECK", "true")) //nolint:usetesting // see comment above
@@ -336,3 +358,37 @@ func sanitizeTestName(name string) string {
 		}
 	}, name)
 }
+
+// TestProviderSecretValuesAreWriteOnly guards that no resource keeps a secret value in state: every
+// secret_value, i.e. every attribute built with secret.ResourceSchema, must be write-only and come with
+// the secret_version triggering its rotation.
+func TestProviderSecretValuesAreWriteOnly(t *testing.T) {
+	var walk func(t *testing.T, attributePath path.Path, attributes map[string]fwschema.Attribute) (secretValues int)
+	walk = func(t *testing.T, attributePath path.Path, attributes map[string]fwschema.Attribute) (secretValues int) {
+		for name, attribute := range attributes {
+			switch attribute := attribute.(type) {
+			case fwschema.SingleNestedAttribute:
+				secretValues += walk(t, attributePath.AtName(name), attribute.Attributes)
+			case fwschema.ListNestedAttribute:
+				secretValues += walk(t, attributePath.AtName(name), attribute.NestedObject.Attributes)
+			case fwschema.SetNestedAttribute:
+				secretValues += walk(t, attributePath.AtName(name), attribute.NestedObject.Attributes)
+			case fwschema.MapNestedAttribute:
+				secretValues += walk(t, attributePath.AtName(name), attribute.NestedObject.Attributes)
+			}
+		}
+		if value, ok := attributes["secret_value"]; ok {
+			secretValues++
+			require.Truef(t, value.IsWriteOnly(), "%s.secret_value must be write-only", attributePath)
+			require.Truef(t, value.IsSensitive(), "%s.secret_value must be sensitive", attributePath)
+			require.Containsf(t, attributes, "secret_version", "%s must have a secret_version to rotate its secret_value", attributePath)
+		}
+		return
+	}
+
+	secretValues := 0
+	for _, newResource := range New("test")().Resources(t.Context()) {
+		secretValues += walk(t, path.Empty(), ResourceSchemaForTest(t, newResource()).Attributes)
+	}
+	require.NotZero(t, secretValues, "no secret_value found, is the walk broken?")
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+// Resource identities let Terraform address a meshObject by structured attributes, e.g. in `import` blocks
+// with `identity = { ... }` and in the import blocks `terraform query` generates from the list resources.
+// Resources set their identity in Create, Read and Update; it never changes for an existing meshObject.
+// Every ImportState accepts both the identity and the resource's import ID, which encodes the same attributes.
+
+// importIdentity returns the identity to import, taken from the `identity` of the import block if one was
+// given, or parsed from the import ID with parseID otherwise.
+func importIdentity[T any](ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics, parseID func(id string, diags *diag.Diagnostics) T) (identity T) {
+	if !importsByIdentity(req) {
+		return parseID(req.ID, diags)
+	}
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	return
+}
+
+func importsByIdentity(req resource.ImportStateRequest) bool {
+	return req.ID == "" && req.Identity != nil && !req.Identity.Raw.IsNull()
+}
+
+// uuidIdentity identifies a meshObject by its metadata.uuid, e.g. a platform or building block.
+type uuidIdentity struct {
+	Uuid string `tfsdk:"uuid"`
+}
+
+func uuidIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"uuid": identityschema.StringAttribute{
+				Description:       "UUID of the " + kind + ".",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// nameIdentity identifies a meshObject by its metadata.name, e.g. a workspace or landing zone.
+type nameIdentity struct {
+	Name string `tfsdk:"name"`
+}
+
+func nameIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "Identifier of the " + kind + ".",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// workspaceScopedIdentity identifies a meshObject whose name is unique within its workspace only,
+// e.g. a project or payment method. Its import ID is `workspace.name`.
+type workspaceScopedIdentity struct {
+	OwnedByWorkspace string `tfsdk:"owned_by_workspace"`
+	Name             string `tfsdk:"name"`
+}
+
+func workspaceScopedIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"owned_by_workspace": identityschema.StringAttribute{
+				Description:       "Identifier of the workspace owning the " + kind + ".",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Identifier of the " + kind + ".",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func parseWorkspaceScopedImportID(format string) func(id string, diags *diag.Diagnostics) workspaceScopedIdentity {
+	return func(id string, diags *diag.Diagnostics) workspaceScopedIdentity {
+		parts := strings.Split(id, ".")
+		if len(parts) != 2 || slices.Contains(parts, "") {
+			diags.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, id),
+			)
+			return workspaceScopedIdentity{}
+		}
+		return workspaceScopedIdentity{OwnedByWorkspace: parts[0], Name: parts[1]}
+	}
+}
+
+// bindingIdentity is implemented by the identities of role bindings. Bindings are looked up by their name alone,
+// so the binding's target in the identity is verified against the existing binding on import.
+type bindingIdentity interface {
+	comparable
+	bindingName() string
+}
+
+// projectBindingIdentity identifies a project user or group binding together with the project it applies to.
+type projectBindingIdentity struct {
+	Workspace string `tfsdk:"workspace"`
+	Project   string `tfsdk:"project"`
+	Name      string `tfsdk:"name"`
+}
+
+func (i projectBindingIdentity) bindingName() string {
+	return i.Name
+}
+
+func newProjectBindingIdentity(binding client.MeshProjectBinding) projectBindingIdentity {
+	return projectBindingIdentity{
+		Workspace: binding.TargetRef.OwnedByWorkspace,
+		Project:   binding.TargetRef.Name,
+		Name:      binding.Metadata.Name,
+	}
+}
+
+func projectBindingIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace": identityschema.StringAttribute{
+				Description:       "Identifier of the workspace owning the project of the " + kind + ".",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "Identifier of the project of the " + kind + ".",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the " + kind + ".",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// workspaceBindingIdentity identifies a workspace user or group binding together with the workspace it applies to.
+type workspaceBindingIdentity struct {
+	Workspace string `tfsdk:"workspace"`
+	Name      string `tfsdk:"name"`
+}
+
+func (i workspaceBindingIdentity) bindingName() string {
+	return i.Name
+}
+
+func newWorkspaceBindingIdentity(binding client.MeshWorkspaceBinding) workspaceBindingIdentity {
+	return workspaceBindingIdentity{
+		Workspace: binding.TargetRef.Name,
+		Name:      binding.Metadata.Name,
+	}
+}
+
+func workspaceBindingIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace": identityschema.StringAttribute{
+				Description:       "Identifier of the workspace of the " + kind + ".",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the " + kind + ".",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// importBindingName returns the name of the binding to import, which is the import ID itself. For an import
+// by identity, readIdentity resolves the identity of the existing binding with that name, and the import fails
+// unless it matches, as Terraform does not check the identity of an imported resource after its first Read.
+func importBindingName[T bindingIdentity](ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics, kind string, readIdentity func(name string) (*T, error)) string {
+	if !importsByIdentity(req) {
+		return req.ID
+	}
+
+	var identity T
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	if diags.HasError() {
+		return ""
+	}
+
+	existing, err := readIdentity(identity.bindingName())
+	if err != nil {
+		diags.AddError("Error importing "+kind, err.Error())
+		return ""
+	}
+	if existing == nil || *existing != identity {
+		diags.AddError("Error importing "+kind, fmt.Sprintf("No %s matches the identity %+v.", kind, identity))
+		return ""
+	}
+	return identity.bindingName()
+}
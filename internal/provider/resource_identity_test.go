@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+// importState runs ImportState of r as Terraform does for an import block with the given id, or with
+// the given identity if id is empty.
+func importState(t *testing.T, r resource.ResourceWithImportState, id string, identity any) resource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+	resourceSchema := ResourceSchemaForTest(t, r)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	req := resource.ImportStateRequest{ID: id, Identity: &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}}
+	if identity != nil {
+		require.False(t, req.Identity.Set(ctx, identity).HasError())
+	}
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{Schema: req.Identity.Schema, Raw: req.Identity.Raw.Copy()},
+	}
+	r.ImportState(ctx, req, &resp)
+	return resp
+}
+
+func importedAttribute(t *testing.T, resp resource.ImportStateResponse, attributePath path.Path) (value string) {
+	t.Helper()
+	require.False(t, resp.State.GetAttribute(context.Background(), attributePath, &value).HasError())
+	return
+}
+
+func TestProjectImportState(t *testing.T) {
+	t.Parallel()
+
+	for name, resp := range map[string]resource.ImportStateResponse{
+		"id":       importState(t, &projectResource{}, "my-workspace.my-project", nil),
+		"identity": importState(t, &projectResource{}, "", workspaceScopedIdentity{OwnedByWorkspace: "my-workspace", Name: "my-project"}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, "my-workspace", importedAttribute(t, resp, path.Root("metadata").AtName("owned_by_workspace")))
+			assert.Equal(t, "my-project", importedAttribute(t, resp, path.Root("metadata").AtName("name")))
+		})
+	}
+
+	for _, id := range []string{"", "my-project", "my-workspace.", "my-workspace.my-project.extra"} {
+		resp := importState(t, &projectResource{}, id, nil)
+		require.True(t, resp.Diagnostics.HasError(), "import ID %q", id)
+		assert.Equal(t, "Unexpected Import Identifier", resp.Diagnostics.Errors()[0].Summary())
+	}
+}
+
+func TestProjectUserBindingImportState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockClient := clientmock.NewMock()
+	binding := client.MeshProjectUserBinding{MeshProjectBinding: client.MeshProjectBinding{
+		Metadata:  client.MeshProjectBindingMetadata{Name: "my-binding"},
+		TargetRef: client.MeshProjectTargetRef{Name: "my-project", OwnedByWorkspace: "my-workspace"},
+	}}
+	_, err := mockClient.ProjectUserBinding.Create(ctx, &binding)
+	require.NoError(t, err)
+	r := &projectUserBindingResource{meshProjectUserBindingClient: mockClient.ProjectUserBinding}
+
+	t.Run("id", func(t *testing.T) {
+		resp := importState(t, r, "my-binding", nil)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, "my-binding", importedAttribute(t, resp, path.Root("metadata").AtName("name")))
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		resp := importState(t, r, "", projectBindingIdentity{Workspace: "my-workspace", Project: "my-project", Name: "my-binding"})
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, "my-binding", importedAttribute(t, resp, path.Root("metadata").AtName("name")))
+	})
+
+	for name, identity := range map[string]projectBindingIdentity{
+		"other project": {Workspace: "my-workspace", Project: "other-project", Name: "my-binding"},
+		"missing":       {Workspace: "my-workspace", Project: "my-project", Name: "other-binding"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := importState(t, r, "", identity)
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "Error importing project user binding", resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
 	clientTypes "github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -26,7 +27,7 @@ func TestServiceInstanceDataSource(t *testing.T) {
 
 		instanceId := "test-instance-id"
 		mockClient := clientmock.NewMock()
-		mockClient.ServiceInstance.Store.Set(instanceId, &client.MeshServiceInstance{
+		require.NoError(t, mockClient.ServiceInstance.Store.Set(instanceId, &client.MeshServiceInstance{
 			Metadata: client.MeshServiceInstanceMetadata{
 				InstanceId:            instanceId,
 				OwnedByWorkspace:      "test-workspace",
@@ -40,7 +41,7 @@ func TestServiceInstanceDataSource(t *testing.T) {
 				ServiceId:   "test-service",
 				Parameters:  map[string]clientTypes.Any{},
 			},
-		})
+		}))
 
 		config := testconfig.DataSource{Name: "service_instance"}.Config(t).WithFirstBlock(
 			testconfig.Descend("metadata", "instance_id")(testconfig.SetString(instanceId)))
@@ -64,7 +65,7 @@ func TestServiceInstanceDataSource(t *testing.T) {
 
 		instanceId := "test-instance-id"
 		mockClient := clientmock.NewMock()
-		mockClient.ServiceInstance.Store.Set(instanceId, &client.MeshServiceInstance{
+		require.NoError(t, mockClient.ServiceInstance.Store.Set(instanceId, &client.MeshServiceInstance{
 			Metadata: client.MeshServiceInstanceMetadata{
 				InstanceId:            instanceId,
 				OwnedByWorkspace:      "test-workspace",
@@ -89,7 +90,7 @@ func TestServiceInstanceDataSource(t *testing.T) {
 					"null_param":  nil,
 				},
 			},
-		})
+		}))
 
 		config := testconfig.DataSource{Name: "service_instance"}.Config(t).WithFirstBlock(
 			testconfig.Descend("metadata", "instance_id")(testconfig.SetString(instanceId)))
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
 	clientTypes "github.com/meshcloud/terraform-provider-meshstack/client/types"
@@ -25,7 +26,7 @@ func TestServiceInstancesDataSource(t *testing.T) {
 		t.Parallel()
 
 		mockClient := clientmock.NewMock()
-		mockClient.ServiceInstance.Store.Set("instance-1", &client.MeshServiceInstance{
+		require.NoError(t, mockClient.ServiceInstance.Store.Set("instance-1", &client.MeshServiceInstance{
 			Metadata: client.MeshServiceInstanceMetadata{
 				InstanceId:            "instance-1",
 				OwnedByWorkspace:      "test-workspace",
@@ -39,8 +40,8 @@ func TestServiceInstancesDataSource(t *testing.T) {
 				ServiceId:   "test-service",
 				Parameters:  map[string]clientTypes.Any{},
 			},
-		})
-		mockClient.ServiceInstance.Store.Set("instance-2", &client.MeshServiceInstance{
+		}))
+		require.NoError(t, mockClient.ServiceInstance.Store.Set("instance-2", &client.MeshServiceInstance{
 			Metadata: client.MeshServiceInstanceMetadata{
 				InstanceId:            "instance-2",
 				OwnedByWorkspace:      "test-workspace",
@@ -54,7 +55,7 @@ func TestServiceInstancesDataSource(t *testing.T) {
 				ServiceId:   "test-service",
 				Parameters:  map[string]clientTypes.Any{},
 			},
-		})
+		}))
 
 		config := testconfig.DataSource{Name: "service_instances"}.Config(t)
 
@@ -76,7 +77,7 @@ func TestServiceInstancesDataSource(t *testing.T) {
 		t.Parallel()
 
 		mockClient := clientmock.NewMock()
-		mockClient.ServiceInstance.Store.Set("instance-1", &client.MeshServiceInstance{
+		require.NoError(t, mockClient.ServiceInstance.Store.Set("instance-1", &client.MeshServiceInstance{
 			Metadata: client.MeshServiceInstanceMetadata{
 				InstanceId:            "instance-1",
 				OwnedByWorkspace:      "test-workspace",
@@ -97,7 +98,7 @@ func TestServiceInstancesDataSource(t *testing.T) {
 					},
 				},
 			},
-		})
+		}))
 
 		config := testconfig.DataSource{Name: "service_instances"}.Config(t)
 
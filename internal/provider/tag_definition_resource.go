@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &tagDefinitionResource{}
 	_ resource.ResourceWithValidateConfig = &tagDefinitionResource{}
 	_ resource.ResourceWithImportState    = &tagDefinitionResource{}
+	_ resource.ResourceWithIdentity       = &tagDefinitionResource{}
 )
 
 var targetKinds = []string{
@@ -324,6 +325,10 @@ type tagValueMultiSelect struct {
 	DefaultValue types.List `json:"defaultValue" tfsdk:"default_value"`
 }
 
+func (r *tagDefinitionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("tag definition")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *tagDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var spec tagDefinitionSpec
@@ -362,15 +367,13 @@ func (r *tagDefinitionResource) Create(ctx context.Context, req resource.CreateR
 
 	tagDefinition, err := r.meshTagDefinitionClient.Create(ctx, &create)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating tag definition",
-			"Could not create tag definition, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating tag definition", err)
 		return
 	}
 
 	diags = resp.State.Set(ctx, tagDefinition)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: tagDefinition.Metadata.Name})...)
 }
 
 func extractStringValuesFromList(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
@@ -458,6 +461,7 @@ func (r *tagDefinitionResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, tagDefinition)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: tagDefinition.Metadata.Name})...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -497,15 +501,13 @@ func (r *tagDefinitionResource) Update(ctx context.Context, req resource.UpdateR
 
 	tagDefinition, err := r.meshTagDefinitionClient.Update(ctx, &update)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating tag definition",
-			"Could not update tag definition, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating tag definition", err)
 		return
 	}
 
 	diags = resp.State.Set(ctx, tagDefinition)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: tagDefinition.Metadata.Name})...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -530,11 +532,16 @@ func (r *tagDefinitionResource) Delete(ctx context.Context, req resource.DeleteR
 
 // ImportState imports the resource state.
 func (r *tagDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Use the resource ID as the name of the tag definition
-	tagDefinitionName := req.ID
+	// The import ID is the name of the tag definition
+	identity := importIdentity(ctx, req, &resp.Diagnostics, func(id string, _ *diag.Diagnostics) nameIdentity {
+		return nameIdentity{Name: id}
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Read the tag definition from the provider
-	tagDefinition, err := r.meshTagDefinitionClient.Read(ctx, tagDefinitionName)
+	tagDefinition, err := r.meshTagDefinitionClient.Read(ctx, identity.Name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing tag definition",
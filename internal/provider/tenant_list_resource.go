@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/types/generic"
+)
+
+var (
+	_ list.ListResource              = &tenantListResource{}
+	_ list.ListResourceWithConfigure = &tenantListResource{}
+)
+
+func NewTenantListResource() list.ListResource {
+	return &tenantListResource{}
+}
+
+type tenantListResource struct {
+	meshTenantClient client.MeshTenantClient
+}
+
+func (r *tenantListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant"
+}
+
+func (r *tenantListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.meshTenantClient = client.Tenant
+	})...)
+}
+
+func (r *tenantListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the tenants in a workspace with optional filters.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_identifier": schema.StringAttribute{
+				MarkdownDescription: "Workspace identifier. Required.",
+				Required:            true,
+			},
+			"project_identifier": schema.StringAttribute{
+				MarkdownDescription: "Project identifier.",
+				Optional:            true,
+			},
+			"platform_identifier": schema.StringAttribute{
+				MarkdownDescription: "Full platform identifier (e.g. `aws.aws-meshstack-dev`).",
+				Optional:            true,
+			},
+			"platform_type_identifier": schema.StringAttribute{
+				MarkdownDescription: "Platform type identifier (e.g. `AWS`).",
+				Optional:            true,
+			},
+			"landing_zone_identifier": schema.StringAttribute{
+				MarkdownDescription: "Landing zone identifier.",
+				Optional:            true,
+			},
+			"platform_tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Platform-specific tenant ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *tenantListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var query client.MeshTenantQuery
+	var diags diag.Diagnostics
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("workspace_identifier"), &query.Workspace)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("project_identifier"), &query.Project)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("platform_identifier"), &query.Platform)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("platform_type_identifier"), &query.PlatformType)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("landing_zone_identifier"), &query.LandingZone)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("platform_tenant_id"), &query.PlatformTenant)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.meshTenantClient.All(ctx, query), "Unable to read tenants", func(tenant client.MeshTenant, result *list.ListResult) {
+		result.DisplayName = tenant.Status.TenantName
+		result.Diagnostics.Append(result.Identity.Set(ctx, uuidIdentity{Uuid: tenant.Metadata.Uuid})...)
+		if req.IncludeResource {
+			// As on import, the requested quotas are unknown and wait_for_completion takes its default.
+			model := tenantResourceModelFromDto(&tenant, nil, true)
+			result.Diagnostics.Append(generic.Set(ctx, result.Resource, model, tenantConverterOptions()...)...)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+func TestTenantListResource(t *testing.T) {
+	ctx := context.Background()
+	mockClient := clientmock.NewMock()
+	for _, project := range []string{"project-a", "project-b"} {
+		_, err := mockClient.Tenant.Create(ctx, &client.MeshTenantCreate{
+			Metadata: client.MeshTenantCreateMetadata{OwnedByWorkspace: "my-workspace", OwnedByProject: project},
+			Spec:     client.MeshTenantCreateSpec{PlatformRef: client.UuidRef{Uuid: "platform-uuid", Kind: client.MeshObjectKind.Platform}},
+		})
+		require.NoError(t, err)
+	}
+	tenantClient := &recordingTenantClient{MeshTenantClient: mockClient.Tenant}
+	listResource := &tenantListResource{meshTenantClient: tenantClient}
+
+	testListResource(t, NewTenantResource(), listResource, &tenantClient.query, func(t *testing.T, result list.ListResult) {
+		var identity uuidIdentity
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		assert.Equal(t, identity.Uuid, listedAttribute(t, result, path.Root("metadata").AtName("uuid")))
+		assert.Equal(t, "my-workspace", listedAttribute(t, result, path.Root("metadata").AtName("owned_by_workspace")))
+	}, listResourceCase[client.MeshTenantQuery]{
+		name: "filters",
+		config: map[string]tftypes.Value{
+			"workspace_identifier":     tftypes.NewValue(tftypes.String, "my-workspace"),
+			"project_identifier":       tftypes.NewValue(tftypes.String, "project-a"),
+			"platform_identifier":      tftypes.NewValue(tftypes.String, "aws.eu"),
+			"platform_type_identifier": tftypes.NewValue(tftypes.String, "AWS"),
+			"landing_zone_identifier":  tftypes.NewValue(tftypes.String, "my-landing-zone"),
+			"platform_tenant_id":       tftypes.NewValue(tftypes.String, "123456789012"),
+		},
+		wantQuery: client.MeshTenantQuery{
+			Workspace:      "my-workspace",
+			Project:        new("project-a"),
+			Platform:       new("aws.eu"),
+			PlatformType:   new("AWS"),
+			LandingZone:    new("my-landing-zone"),
+			PlatformTenant: new("123456789012"),
+		},
+	}, listResourceCase[client.MeshTenantQuery]{
+		name:             "include resource",
+		config:           map[string]tftypes.Value{"workspace_identifier": tftypes.NewValue(tftypes.String, "my-workspace")},
+		includeResource:  true,
+		wantQuery:        client.MeshTenantQuery{Workspace: "my-workspace"},
+		wantDisplayNames: []string{"my-workspace.project-a.platform-uuid", "my-workspace.project-b.platform-uuid"},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure    = &tenantResource{}
 	_ resource.ResourceWithImportState  = &tenantResource{}
 	_ resource.ResourceWithUpgradeState = &tenantResource{}
+	_ resource.ResourceWithIdentity     = &tenantResource{}
 )
 
 func NewTenantResource() resource.Resource {
@@ -77,7 +79,7 @@ func (r *tenantResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tenant, err := r.meshTenantClient.Create(ctx, &createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating tenant", fmt.Sprintf("Could not create tenant, unexpected error: %s", err.Error()))
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating tenant", err)
 		return
 	}
 
@@ -94,6 +96,11 @@ func (r *tenantResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	model := tenantResourceModelFromDto(tenant, plan.Spec.RequestedQuotas, plan.WaitForCompletion)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, model, tenantConverterOptions()...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: tenant.Metadata.Uuid})...)
+}
+
+func (r *tenantResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = uuidIdentitySchema("tenant")
 }
 
 func (r *tenantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,6 +128,7 @@ func (r *tenantResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// from state rather than deriving it from the backend's effective quotas.
 	model := tenantResourceModelFromDto(tenant, state.Spec.RequestedQuotas, state.WaitForCompletion)
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, model, tenantConverterOptions()...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: tenant.Metadata.Uuid})...)
 }
 
 func (r *tenantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -149,6 +157,7 @@ func (r *tenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(generic.Set(ctx, &resp.State, plan, tenantConverterOptions()...)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, uuidIdentity{Uuid: plan.Metadata.Uuid})...)
 }
 
 func (r *tenantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -182,34 +191,40 @@ func (r *tenantResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState accepts either a tenant UUID or the legacy `workspace.project.platform.location`
 // composite identifier (the shape the v3 meshstack_tenant used, where `platform.location` is the full
-// platform identifier), resolving the latter to a uuid via the list endpoint.
+// platform identifier), resolving the latter to a uuid via the list endpoint. An import by identity
+// takes the uuid directly.
 func (r *tenantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-
-	switch len(parts) {
-	case 1:
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("uuid"), parts[0])...)
-	case 4:
-		if parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
-			resp.Diagnostics.AddError("Incomplete Import Identifier", fmt.Sprintf("Encountered empty import identifier field. Got: %q", req.ID))
-			return
-		}
-		workspace, project := parts[0], parts[1]
-		platform := parts[2] + "." + parts[3]
-		tenant, err := r.listSingleTenant(ctx, workspace, project, platform)
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to import tenant", err.Error())
-			return
+	identity := importIdentity(ctx, req, &resp.Diagnostics, func(id string, diags *diag.Diagnostics) (identity uuidIdentity) {
+		parts := strings.Split(id, ".")
+		switch len(parts) {
+		case 1:
+			identity.Uuid = parts[0]
+		case 4:
+			if parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+				diags.AddError("Incomplete Import Identifier", fmt.Sprintf("Encountered empty import identifier field. Got: %q", id))
+				return
+			}
+			workspace, project := parts[0], parts[1]
+			platform := parts[2] + "." + parts[3]
+			tenant, err := r.listSingleTenant(ctx, workspace, project, platform)
+			if err != nil {
+				diags.AddError("Failed to import tenant", err.Error())
+				return
+			}
+			identity.Uuid = tenant.Metadata.Uuid
+		default:
+			diags.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected either a tenant UUID or an identifier with format workspace.project.platform.location. Got: %q", id),
+			)
 		}
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("uuid"), tenant.Metadata.Uuid)...)
-	default:
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected either a tenant UUID or an identifier with format workspace.project.platform.location. Got: %q", req.ID),
-		)
+		return
+	})
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("uuid"), identity.Uuid)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), types.BoolValue(true))...)
 }
 
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &workspaceGroupBindingResource{}
 	_ resource.ResourceWithConfigure   = &workspaceGroupBindingResource{}
 	_ resource.ResourceWithImportState = &workspaceGroupBindingResource{}
+	_ resource.ResourceWithIdentity    = &workspaceGroupBindingResource{}
 )
 
 // NewWorkspaceGroupBindingResource is a helper function to simplify the provider implementation.
@@ -115,6 +116,10 @@ func (r *workspaceGroupBindingResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+func (r *workspaceGroupBindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceBindingIdentitySchema("workspace group binding")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workspaceGroupBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan client.MeshWorkspaceGroupBinding
@@ -127,15 +132,13 @@ func (r *workspaceGroupBindingResource) Create(ctx context.Context, req resource
 
 	binding, err := r.meshWorkspaceGroupBindingClient.Create(ctx, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating workspace group binding",
-			"Could not create workspace group binding, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating workspace group binding", err)
 		return
 	}
 
 	diags = resp.State.Set(ctx, binding)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newWorkspaceBindingIdentity(binding.MeshWorkspaceBinding))...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -157,6 +160,7 @@ func (r *workspaceGroupBindingResource) Read(ctx context.Context, req resource.R
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, binding)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newWorkspaceBindingIdentity(binding.MeshWorkspaceBinding))...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -183,5 +187,16 @@ func (r *workspaceGroupBindingResource) Delete(ctx context.Context, req resource
 }
 
 func (r *workspaceGroupBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), req.ID)...)
+	name := importBindingName(ctx, req, &resp.Diagnostics, "workspace group binding", func(name string) (*workspaceBindingIdentity, error) {
+		binding, err := r.meshWorkspaceGroupBindingClient.Read(ctx, name)
+		if binding == nil {
+			return nil, err
+		}
+		return new(newWorkspaceBindingIdentity(binding.MeshWorkspaceBinding)), err
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), name)...)
 }
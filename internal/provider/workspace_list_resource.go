@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+var (
+	_ list.ListResource              = &workspaceListResource{}
+	_ list.ListResourceWithConfigure = &workspaceListResource{}
+)
+
+func NewWorkspaceListResource() list.ListResource {
+	return &workspaceListResource{}
+}
+
+type workspaceListResource struct {
+	meshWorkspaceClient client.MeshWorkspaceClient
+}
+
+func (r *workspaceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (r *workspaceListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(configureProviderClient(req.ProviderData, func(client client.Client) {
+		r.meshWorkspaceClient = client.Workspace
+	})...)
+}
+
+func (r *workspaceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all workspaces visible to the provider's credentials.",
+	}
+}
+
+func (r *workspaceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listResults(ctx, req, r.meshWorkspaceClient.All(ctx), "Unable to list workspaces", func(workspace client.MeshWorkspace, result *list.ListResult) {
+		result.DisplayName = workspace.Spec.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, nameIdentity{Name: workspace.Metadata.Name})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, newWorkspaceModel(&workspace))...)
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/clientmock"
+)
+
+// newListRequest returns a request to list the resources of r with listResource, configured with the given
+// values. Attributes left out of config are null.
+func newListRequest(t *testing.T, r resource.Resource, listResource list.ListResource, config map[string]tftypes.Value, limit int64, includeResource bool) list.ListRequest {
+	t.Helper()
+	ctx := context.Background()
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	var configSchemaResp list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+	objectType := configSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		require.Contains(t, objectType.AttributeTypes, name)
+		values[name] = value
+	}
+	return list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configSchemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		ResourceSchema:         ResourceSchemaForTest(t, r),
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+		Limit:                  limit,
+		IncludeResource:        includeResource,
+	}
+}
+
+func newWorkspaceListRequest(t *testing.T, limit int64, includeResource bool) list.ListRequest {
+	t.Helper()
+	return newListRequest(t, NewWorkspaceResource(), &workspaceListResource{}, nil, limit, includeResource)
+}
+
+// listAllForTest runs List of listResource and returns its results, which must not have errors.
+func listAllForTest(t *testing.T, listResource list.ListResource, req list.ListRequest) []list.ListResult {
+	t.Helper()
+	var stream list.ListResultsStream
+	listResource.List(context.Background(), req, &stream)
+	results := slices.Collect(stream.Results)
+	for _, result := range results {
+		require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+	}
+	return results
+}
+
+// listResourceCase is a case of testListResource.
+type listResourceCase[Q any] struct {
+	name            string
+	config          map[string]tftypes.Value
+	includeResource bool
+	// wantQuery is the query List must send to the client.
+	wantQuery Q
+	// wantDisplayNames are the display names of the listed resources, in any order.
+	wantDisplayNames []string
+}
+
+// testListResource runs List of listResource for each case. The client of listResource must be one of the
+// recording clients below, keeping the query it was last sent in query. With the resource included, checkResult
+// checks the listed resource against the identity of result.
+func testListResource[Q any](t *testing.T, r resource.Resource, listResource list.ListResource, query *Q, checkResult func(t *testing.T, result list.ListResult), cases ...listResourceCase[Q]) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			*query = *new(Q)
+			results := listAllForTest(t, listResource, newListRequest(t, r, listResource, tc.config, 0, tc.includeResource))
+			assert.Equal(t, tc.wantQuery, *query)
+			var displayNames []string
+			for _, result := range results {
+				displayNames = append(displayNames, result.DisplayName)
+				if tc.includeResource {
+					checkResult(t, result)
+				} else {
+					assert.True(t, result.Resource.Raw.IsNull())
+				}
+			}
+			assert.ElementsMatch(t, tc.wantDisplayNames, displayNames)
+		})
+	}
+}
+
+// recordingTenantClient is a mock MeshTenantClient that records the query of the last list.
+type recordingTenantClient struct {
+	clientmock.MeshTenantClient
+	query client.MeshTenantQuery
+}
+
+func (c *recordingTenantClient) All(ctx context.Context, query client.MeshTenantQuery) iter.Seq2[client.MeshTenant, error] {
+	c.query = query
+	return c.MeshTenantClient.All(ctx, query)
+}
+
+// recordedProjectQuery are the arguments of the last list of recordingProjectClient.
+type recordedProjectQuery struct {
+	workspaceIdentifier     string
+	paymentMethodIdentifier *string
+}
+
+// recordingProjectClient is a mock MeshProjectClient that records the query of the last list.
+type recordingProjectClient struct {
+	clientmock.MeshProjectClient
+	query recordedProjectQuery
+}
+
+func (c *recordingProjectClient) All(ctx context.Context, workspaceIdentifier string, paymentMethodIdentifier *string) iter.Seq2[client.MeshProject, error] {
+	c.query = recordedProjectQuery{workspaceIdentifier, paymentMethodIdentifier}
+	return c.MeshProjectClient.All(ctx, workspaceIdentifier, paymentMethodIdentifier)
+}
+
+// recordingLandingZoneClient is a mock MeshLandingZoneClient that records the query of the last list.
+type recordingLandingZoneClient struct {
+	clientmock.MeshLandingZoneClient
+	query client.MeshLandingZoneListQuery
+}
+
+func (c *recordingLandingZoneClient) All(ctx context.Context, query client.MeshLandingZoneListQuery) iter.Seq2[client.MeshLandingZone, error] {
+	c.query = query
+	return c.MeshLandingZoneClient.All(ctx, query)
+}
+
+// recordingPlatformClient is a mock MeshPlatformClient that records the query of the last list.
+type recordingPlatformClient struct {
+	clientmock.MeshPlatformClient
+	query client.MeshPlatformListQuery
+}
+
+func (c *recordingPlatformClient) All(ctx context.Context, query client.MeshPlatformListQuery) iter.Seq2[client.MeshPlatform, error] {
+	c.query = query
+	return c.MeshPlatformClient.All(ctx, query)
+}
+
+// recordingBuildingBlockClient is a mock MeshBuildingBlockV2Client that records the filter of the last list.
+type recordingBuildingBlockClient struct {
+	clientmock.MeshBuildingBlockV2Client
+	query client.MeshBuildingBlockV2ListFilter
+}
+
+func (c *recordingBuildingBlockClient) All(ctx context.Context, filter client.MeshBuildingBlockV2ListFilter) iter.Seq2[client.MeshBuildingBlockV2, error] {
+	c.query = filter
+	return c.MeshBuildingBlockV2Client.All(ctx, filter)
+}
+
+// listedAttribute returns the string attribute of a listed resource.
+func listedAttribute(t *testing.T, result list.ListResult, attributePath path.Path) (value string) {
+	t.Helper()
+	require.False(t, result.Resource.GetAttribute(context.Background(), attributePath, &value).HasError())
+	return
+}
+
+func TestWorkspaceListResource(t *testing.T) {
+	ctx := context.Background()
+	mockClient := clientmock.NewMock()
+	for _, name := range []string{"ws-a", "ws-b", "ws-c"} {
+		_, err := mockClient.Workspace.Create(ctx, &client.MeshWorkspaceCreate{
+			Metadata: client.MeshWorkspaceCreateMetadata{Name: name},
+			Spec:     client.MeshWorkspaceSpec{DisplayName: "Workspace " + name},
+		})
+		require.NoError(t, err)
+	}
+	listResource := &workspaceListResource{meshWorkspaceClient: mockClient.Workspace}
+
+	listWorkspaces := func(t *testing.T, req list.ListRequest) (names, displayNames []string) {
+		t.Helper()
+		var stream list.ListResultsStream
+		listResource.List(ctx, req, &stream)
+		for result := range stream.Results {
+			require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+			var identity nameIdentity
+			require.False(t, result.Identity.Get(ctx, &identity).HasError())
+			names = append(names, identity.Name)
+			displayNames = append(displayNames, result.DisplayName)
+			if req.IncludeResource {
+				var name string
+				require.False(t, result.Resource.GetAttribute(ctx, path.Root("metadata").AtName("name"), &name).HasError())
+				assert.Equal(t, identity.Name, name)
+			} else {
+				assert.True(t, result.Resource.Raw.IsNull())
+			}
+		}
+		return
+	}
+
+	t.Run("all", func(t *testing.T) {
+		names, displayNames := listWorkspaces(t, newWorkspaceListRequest(t, 0, true))
+		assert.Equal(t, []string{"ws-a", "ws-b", "ws-c"}, names)
+		assert.Equal(t, []string{"Workspace ws-a", "Workspace ws-b", "Workspace ws-c"}, displayNames)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		names, _ := listWorkspaces(t, newWorkspaceListRequest(t, 2, false))
+		assert.Equal(t, []string{"ws-a", "ws-b"}, names)
+	})
+}
+
+func TestListResultsStopsAtError(t *testing.T) {
+	ctx := context.Background()
+	items := func(yield func(string, error) bool) {
+		_ = yield("first", nil) && yield("", errors.New("boom")) && yield("never", nil)
+	}
+	var pushed []string
+	results := slices.Collect(listResults(ctx, newWorkspaceListRequest(t, 0, false), items, "Unable to list things", func(item string, _ *list.ListResult) {
+		pushed = append(pushed, item)
+	}))
+
+	assert.Equal(t, []string{"first"}, pushed)
+	require.Len(t, results, 2)
+	assert.False(t, results[0].Diagnostics.HasError())
+	require.True(t, results[1].Diagnostics.HasError())
+	assert.Equal(t, "Unable to list things", results[1].Diagnostics.Errors()[0].Summary())
+	assert.Equal(t, "boom", results[1].Diagnostics.Errors()[0].Detail())
+}
@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                = &workspaceResource{}
 	_ resource.ResourceWithConfigure   = &workspaceResource{}
 	_ resource.ResourceWithImportState = &workspaceResource{}
+	_ resource.ResourceWithIdentity    = &workspaceResource{}
 )
 
 // NewWorkspaceResource is a helper function to simplify the provider implementation.
@@ -110,6 +111,10 @@ func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *workspaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("workspace")
+}
+
 func (r *workspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	workspace := client.MeshWorkspaceCreate{
 		Metadata: client.MeshWorkspaceCreateMetadata{},
@@ -126,10 +131,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 
 	createdWorkspace, err := r.meshWorkspaceClient.Create(ctx, &workspace)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Workspace",
-			"Could not create workspace, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Creating Workspace", err)
 		return
 	}
 
@@ -140,6 +142,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	createdWorkspace.Metadata.Tags = workspace.Metadata.Tags
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newWorkspaceModel(createdWorkspace))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: createdWorkspace.Metadata.Name})...)
 }
 
 func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,7 +155,7 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	workspace, err := r.meshWorkspaceClient.Read(ctx, name)
+	workspace, etag, err := r.meshWorkspaceClient.ReadWithETag(ctx, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Could not read workspace '%s'", name),
@@ -177,6 +180,8 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// client data maps directly to the schema so we just need to set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, newWorkspaceModel(workspace))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: workspace.Metadata.Name})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, etag)
 }
 
 func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -193,12 +198,14 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	updatedWorkspace, err := r.meshWorkspaceClient.Update(ctx, workspace.Metadata.Name, &workspace)
+	etag := getPrivateETag(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedWorkspace, newETag, err := r.meshWorkspaceClient.UpdateIfMatch(ctx, workspace.Metadata.Name, etag, &workspace)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Workspace",
-			"Could not update workspace, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error Updating Workspace", err)
 		return
 	}
 
@@ -206,6 +213,8 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	updatedWorkspace.Metadata.Tags = workspace.Metadata.Tags
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newWorkspaceModel(updatedWorkspace))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentity{Name: updatedWorkspace.Metadata.Name})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, newETag)
 }
 
 func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -228,5 +237,5 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *workspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("metadata").AtName("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("metadata").AtName("name"), path.Root("name"), req, resp)
 }
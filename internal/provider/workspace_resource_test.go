@@ -7,9 +7,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/meshcloud/terraform-provider-meshstack/client"
 	"github.com/meshcloud/terraform-provider-meshstack/internal/provider/acctest/testconfig"
@@ -48,6 +50,28 @@ func TestAccWorkspace(t *testing.T) {
 		})
 	})
 
+	t.Run("query", func(t *testing.T) {
+		config, _ := testconfig.Workspace(t)
+
+		ApplyAndTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_14_0)},
+			Steps: []resource.TestStep{
+				{
+					Config: config.String(),
+				},
+				{
+					Query: true,
+					Config: `list "meshstack_workspace" "all" {
+						provider = meshstack
+					}`,
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLengthAtLeast("meshstack_workspace.all", 1),
+					},
+				},
+			},
+		})
+	})
+
 	config, resourceAddress := testconfig.Workspace(t)
 
 	updateConfig := config.WithFirstBlock(
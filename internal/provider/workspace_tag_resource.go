@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &workspaceTagResource{}
 	_ resource.ResourceWithConfigure   = &workspaceTagResource{}
 	_ resource.ResourceWithImportState = &workspaceTagResource{}
+	_ resource.ResourceWithIdentity    = &workspaceTagResource{}
 )
 
 // Both dedicated tag resources are read-modify-write wrappers around the whole meshWorkspace
@@ -43,11 +46,15 @@ const workspaceTagCaveats = "!> **Not recommended for general use.** Prefer mana
 	"tag on that workspace can be managed with this resource — use inline `metadata.tags` on `meshstack_workspace` " +
 	"instead. `meshstack_workspace_tags` is unaffected: it sends exactly the tags you configure.\n\n" +
 
-	"~> **Race conditions.** The read-modify-write cycle is not atomic and the API offers no optimistic locking. A " +
-	"concurrent write to the same workspace — another apply of these resources, the `meshstack_workspace` resource " +
-	"itself, a panel user, or any other automation — can silently clobber this resource's tags or be clobbered by " +
-	"them. **This includes a single apply:** Terraform walks resources that do not depend on each other in parallel " +
-	"(`-parallelism`, 10 by default) and the provider does not serialize these writes, so two " +
+	"~> **Race conditions.** The read-modify-write cycle is not atomic. The write is made conditional on the " +
+	"workspace being unchanged since Terraform last read it (`If-Match` with the workspace's ETag from the refresh), so " +
+	"if anything changed the workspace since the plan — a panel user, other automation, or another resource of the " +
+	"same apply — the apply fails with *object changed since last refresh, re-plan*; run the apply again to pick up " +
+	"the other change. In particular, if several resources writing the same workspace change in one apply, only the " +
+	"first write succeeds and the others need another apply. Without an ETag from meshStack, the write is " +
+	"unconditional and a concurrent write to the same workspace can silently clobber this resource's tags or be " +
+	"clobbered by them. **This includes a single apply:** Terraform walks resources that do not depend on each other " +
+	"in parallel (`-parallelism`, 10 by default) and the provider does not serialize these writes, so two " +
 	"`meshstack_workspace_tag` resources on the same workspace can each read the same tag map and then overwrite each " +
 	"other — one tag silently goes missing while the apply reports success. Manage all tags of a workspace from a " +
 	"single resource in a single Terraform state; if you must spread them across several `meshstack_workspace_tag` " +
@@ -84,6 +91,16 @@ type workspaceTagModel struct {
 	Spec     workspaceTagSpec     `tfsdk:"spec"`
 }
 
+// workspaceTagIdentity identifies a single tag of a workspace by the attributes of its import ID.
+type workspaceTagIdentity struct {
+	WorkspaceIdentifier string `tfsdk:"workspace_identifier"`
+	Key                 string `tfsdk:"key"`
+}
+
+func (m workspaceTagModel) identity() workspaceTagIdentity {
+	return workspaceTagIdentity{WorkspaceIdentifier: m.Metadata.WorkspaceIdentifier.ValueString(), Key: m.Metadata.Key.ValueString()}
+}
+
 func (r *workspaceTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workspace_tag"
 }
@@ -138,6 +155,21 @@ func (r *workspaceTagResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *workspaceTagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_identifier": identityschema.StringAttribute{
+				Description:       "Identifier of the workspace the tag is set on.",
+				RequiredForImport: true,
+			},
+			"key": identityschema.StringAttribute{
+				Description:       "Key of the tag.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *workspaceTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan workspaceTagModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -148,7 +180,7 @@ func (r *workspaceTagResource) Create(ctx context.Context, req resource.CreateRe
 	wsName := plan.Metadata.WorkspaceIdentifier.ValueString()
 	key := plan.Metadata.Key.ValueString()
 
-	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
+	workspace, etag, err := r.meshWorkspaceClient.ReadWithETag(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
 		return
@@ -174,8 +206,9 @@ func (r *workspaceTagResource) Create(ctx context.Context, req resource.CreateRe
 		Metadata: client.MeshWorkspaceCreateMetadata{Name: wsName, Tags: tags},
 		Spec:     workspace.Spec,
 	}
-	if _, err := r.meshWorkspaceClient.Update(ctx, wsName, &updatePayload); err != nil {
-		resp.Diagnostics.AddError("Error Updating Workspace Tag", fmt.Sprintf("Could not set tag '%s' on workspace '%s': %v", key, wsName, err))
+	_, newETag, err := r.meshWorkspaceClient.UpdateIfMatch(ctx, wsName, etag, &updatePayload)
+	if err != nil {
+		addConditionalWriteError(ctx, &resp.Diagnostics, req.Plan, "Error Updating Workspace Tag", fmt.Sprintf("Could not set tag '%s' on workspace '%s'", key, wsName), err)
 		return
 	}
 
@@ -183,6 +216,8 @@ func (r *workspaceTagResource) Create(ctx context.Context, req resource.CreateRe
 	// normalize or default them, and writing that into the Required spec.values would break
 	// plan/apply consistency. Mirrors workspace_resource.go.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, newETag)
 }
 
 func (r *workspaceTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -195,7 +230,7 @@ func (r *workspaceTagResource) Read(ctx context.Context, req resource.ReadReques
 	wsName := state.Metadata.WorkspaceIdentifier.ValueString()
 	key := state.Metadata.Key.ValueString()
 
-	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
+	workspace, etag, err := r.meshWorkspaceClient.ReadWithETag(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
 		return
@@ -224,6 +259,8 @@ func (r *workspaceTagResource) Read(ctx context.Context, req resource.ReadReques
 	state.Spec.Values = valList
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, etag)
 }
 
 func (r *workspaceTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -236,6 +273,7 @@ func (r *workspaceTagResource) Update(ctx context.Context, req resource.UpdateRe
 	wsName := plan.Metadata.WorkspaceIdentifier.ValueString()
 	key := plan.Metadata.Key.ValueString()
 
+	etag := getPrivateETag(ctx, req.Private, &resp.Diagnostics)
 	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
@@ -262,13 +300,16 @@ func (r *workspaceTagResource) Update(ctx context.Context, req resource.UpdateRe
 		Metadata: client.MeshWorkspaceCreateMetadata{Name: wsName, Tags: tags},
 		Spec:     workspace.Spec,
 	}
-	if _, err := r.meshWorkspaceClient.Update(ctx, wsName, &updatePayload); err != nil {
-		resp.Diagnostics.AddError("Error Updating Workspace Tag", fmt.Sprintf("Could not set tag '%s' on workspace '%s': %v", key, wsName, err))
+	_, newETag, err := r.meshWorkspaceClient.UpdateIfMatch(ctx, wsName, etag, &updatePayload)
+	if err != nil {
+		addConditionalWriteError(ctx, &resp.Diagnostics, req.Plan, "Error Updating Workspace Tag", fmt.Sprintf("Could not set tag '%s' on workspace '%s'", key, wsName), err)
 		return
 	}
 
 	// Keep the declared values rather than the API's, mirroring Create.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, newETag)
 }
 
 func (r *workspaceTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -281,6 +322,7 @@ func (r *workspaceTagResource) Delete(ctx context.Context, req resource.DeleteRe
 	wsName := state.Metadata.WorkspaceIdentifier.ValueString()
 	key := state.Metadata.Key.ValueString()
 
+	etag := getPrivateETag(ctx, req.Private, &resp.Diagnostics)
 	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
@@ -302,25 +344,31 @@ func (r *workspaceTagResource) Delete(ctx context.Context, req resource.DeleteRe
 		Metadata: client.MeshWorkspaceCreateMetadata{Name: wsName, Tags: tags},
 		Spec:     workspace.Spec,
 	}
-	_, err = r.meshWorkspaceClient.Update(ctx, wsName, &updatePayload)
+	_, _, err = r.meshWorkspaceClient.UpdateIfMatch(ctx, wsName, etag, &updatePayload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Removing Workspace Tag", fmt.Sprintf("Could not remove tag '%s' from workspace '%s': %v", key, wsName, err))
+		addConditionalWriteError(ctx, &resp.Diagnostics, req.State, "Error Removing Workspace Tag", fmt.Sprintf("Could not remove tag '%s' from workspace '%s'", key, wsName), err)
 		return
 	}
 }
 
 func (r *workspaceTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, ".", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: workspace_identifier.key. Got: %q", req.ID),
-		)
+	identity := importIdentity(ctx, req, &resp.Diagnostics, func(id string, diags *diag.Diagnostics) workspaceTagIdentity {
+		parts := strings.SplitN(id, ".", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			diags.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: workspace_identifier.key. Got: %q", id),
+			)
+			return workspaceTagIdentity{}
+		}
+		return workspaceTagIdentity{WorkspaceIdentifier: parts[0], Key: parts[1]}
+	})
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("workspace_identifier"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("key"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("workspace_identifier"), identity.WorkspaceIdentifier)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("key"), identity.Key)...)
 
 	// Leave spec.values null rather than empty: Read distinguishes "no prior state" (import) from a
 	// tracked empty list, and only the latter may treat an absent tag key as still present. Setting the
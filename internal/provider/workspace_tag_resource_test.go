@@ -69,6 +69,42 @@ func TestAccWorkspaceTag(t *testing.T) {
 		})
 	})
 
+	t.Run("workspace_changed_since_refresh", func(t *testing.T) {
+		// Each write is conditional on the ETag of the refresh, so when both tags change in one apply, the
+		// second write finds the workspace changed by the first and must fail rather than overwrite it.
+		workspaceConfig, workspaceAddr := testconfig.WorkspaceWithoutTags(t)
+		firstConfig, firstAddr, _ := testconfig.WorkspaceTag(t, workspaceAddr)
+		secondConfig, _, _ := testconfig.WorkspaceTag(t, workspaceAddr)
+		secondConfig = secondConfig.WithFirstBlock(
+			testconfig.Descend("depends_on")(testconfig.SetRawExpr("[%s]", firstAddr)),
+		)
+		bothTags := firstConfig.Join(secondConfig, workspaceConfig)
+		bothTagsChanged := firstConfig.WithFirstBlock(
+			testconfig.Descend("spec", "values")(testconfig.SetRawExpr(`["changed"]`)),
+		).Join(secondConfig.WithFirstBlock(
+			testconfig.Descend("spec", "values")(testconfig.SetRawExpr(`["changed"]`)),
+		), workspaceConfig)
+
+		ApplyAndTest(t, resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config: bothTags.String(),
+				},
+				{
+					Config:      bothTagsChanged.String(),
+					ExpectError: regexp.MustCompile(`object changed since last refresh, re-plan`),
+				},
+				{
+					// The next apply refreshes the ETag and writes the remaining change.
+					Config: bothTagsChanged.String(),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PostApplyPostRefresh: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+					},
+				},
+			},
+		})
+	})
+
 	t.Run("workspace_not_found", func(t *testing.T) {
 		// This resource cannot create the workspace it tags, so a missing one must be a clear error
 		// rather than a nil-deref or a silent no-op.
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -18,6 +20,7 @@ var (
 	_ resource.Resource                = &workspaceTagsResource{}
 	_ resource.ResourceWithConfigure   = &workspaceTagsResource{}
 	_ resource.ResourceWithImportState = &workspaceTagsResource{}
+	_ resource.ResourceWithIdentity    = &workspaceTagsResource{}
 )
 
 func NewWorkspaceTagsResource() resource.Resource {
@@ -41,6 +44,11 @@ type workspaceTagsModel struct {
 	Spec     workspaceTagsSpec     `tfsdk:"spec"`
 }
 
+// workspaceTagsIdentity identifies the tags of a workspace by the workspace, which is also the import ID.
+type workspaceTagsIdentity struct {
+	WorkspaceIdentifier string `tfsdk:"workspace_identifier"`
+}
+
 func (r *workspaceTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workspace_tags"
 }
@@ -96,6 +104,17 @@ func (r *workspaceTagsResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *workspaceTagsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_identifier": identityschema.StringAttribute{
+				Description:       "Identifier of the workspace whose tags are managed.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *workspaceTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan workspaceTagsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -104,7 +123,7 @@ func (r *workspaceTagsResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	wsName := plan.Metadata.WorkspaceIdentifier.ValueString()
-	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
+	workspace, etag, err := r.meshWorkspaceClient.ReadWithETag(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
 		return
@@ -126,8 +145,9 @@ func (r *workspaceTagsResource) Create(ctx context.Context, req resource.CreateR
 		Metadata: client.MeshWorkspaceCreateMetadata{Name: wsName, Tags: tags},
 		Spec:     workspace.Spec,
 	}
-	if _, err := r.meshWorkspaceClient.Update(ctx, wsName, &updatePayload); err != nil {
-		resp.Diagnostics.AddError("Error Updating Workspace Tags", fmt.Sprintf("Could not update tags for workspace '%s': %v", wsName, err))
+	_, newETag, err := r.meshWorkspaceClient.UpdateIfMatch(ctx, wsName, etag, &updatePayload)
+	if err != nil {
+		addConditionalWriteError(ctx, &resp.Diagnostics, req.Plan, "Error Updating Workspace Tags", fmt.Sprintf("Could not update tags for workspace '%s'", wsName), err)
 		return
 	}
 
@@ -136,6 +156,8 @@ func (r *workspaceTagsResource) Create(ctx context.Context, req resource.CreateR
 	// either of which would break plan/apply consistency on the Required spec.tags. Mirrors
 	// workspace_resource.go.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceTagsIdentity{WorkspaceIdentifier: plan.Metadata.WorkspaceIdentifier.ValueString()})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, newETag)
 }
 
 func (r *workspaceTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -146,7 +168,7 @@ func (r *workspaceTagsResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	wsName := state.Metadata.WorkspaceIdentifier.ValueString()
-	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
+	workspace, etag, err := r.meshWorkspaceClient.ReadWithETag(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
 		return
@@ -176,6 +198,8 @@ func (r *workspaceTagsResource) Read(ctx context.Context, req resource.ReadReque
 	state.Spec.Tags = tagsMap
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceTagsIdentity{WorkspaceIdentifier: state.Metadata.WorkspaceIdentifier.ValueString()})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, etag)
 }
 
 func (r *workspaceTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -186,6 +210,7 @@ func (r *workspaceTagsResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	wsName := plan.Metadata.WorkspaceIdentifier.ValueString()
+	etag := getPrivateETag(ctx, req.Private, &resp.Diagnostics)
 	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
@@ -208,13 +233,16 @@ func (r *workspaceTagsResource) Update(ctx context.Context, req resource.UpdateR
 		Metadata: client.MeshWorkspaceCreateMetadata{Name: wsName, Tags: tags},
 		Spec:     workspace.Spec,
 	}
-	if _, err := r.meshWorkspaceClient.Update(ctx, wsName, &updatePayload); err != nil {
-		resp.Diagnostics.AddError("Error Updating Workspace Tags", fmt.Sprintf("Could not update tags for workspace '%s': %v", wsName, err))
+	_, newETag, err := r.meshWorkspaceClient.UpdateIfMatch(ctx, wsName, etag, &updatePayload)
+	if err != nil {
+		addConditionalWriteError(ctx, &resp.Diagnostics, req.Plan, "Error Updating Workspace Tags", fmt.Sprintf("Could not update tags for workspace '%s'", wsName), err)
 		return
 	}
 
 	// Keep the declared tags rather than the API's superset, mirroring Create.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, workspaceTagsIdentity{WorkspaceIdentifier: plan.Metadata.WorkspaceIdentifier.ValueString()})...)
+	setPrivateETag(ctx, resp.Private, &resp.Diagnostics, newETag)
 }
 
 func (r *workspaceTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -225,6 +253,7 @@ func (r *workspaceTagsResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	wsName := state.Metadata.WorkspaceIdentifier.ValueString()
+	etag := getPrivateETag(ctx, req.Private, &resp.Diagnostics)
 	workspace, err := r.meshWorkspaceClient.Read(ctx, wsName)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Workspace", fmt.Sprintf("Could not read workspace '%s': %v", wsName, err))
@@ -240,25 +269,32 @@ func (r *workspaceTagsResource) Delete(ctx context.Context, req resource.DeleteR
 		Metadata: client.MeshWorkspaceCreateMetadata{Name: wsName, Tags: make(map[string][]string)},
 		Spec:     workspace.Spec,
 	}
-	_, err = r.meshWorkspaceClient.Update(ctx, wsName, &updatePayload)
+	_, _, err = r.meshWorkspaceClient.UpdateIfMatch(ctx, wsName, etag, &updatePayload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Clearing Workspace Tags", fmt.Sprintf("Could not clear tags for workspace '%s': %v", wsName, err))
+		addConditionalWriteError(ctx, &resp.Diagnostics, req.State, "Error Clearing Workspace Tags", fmt.Sprintf("Could not clear tags for workspace '%s'", wsName), err)
 		return
 	}
 }
 
 func (r *workspaceTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identity := importIdentity(ctx, req, &resp.Diagnostics, func(id string, _ *diag.Diagnostics) workspaceTagsIdentity {
+		return workspaceTagsIdentity{WorkspaceIdentifier: id}
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// An empty identifier would target the workspace collection endpoint, whose list response unmarshals
 	// into a zero-valued workspace without error — an import that "succeeds" with garbage state.
-	if req.ID == "" {
+	if identity.WorkspaceIdentifier == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: workspace_identifier. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: workspace_identifier. Got: %q", identity.WorkspaceIdentifier),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("workspace_identifier"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("workspace_identifier"), identity.WorkspaceIdentifier)...)
 
 	// Leave spec.tags null rather than empty: Read passes the API's tags through unchanged when there is
 	// no prior state, so an import round-trips every tag on the workspace instead of reconciling against
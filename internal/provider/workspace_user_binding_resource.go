@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &workspaceUserBindingResource{}
 	_ resource.ResourceWithConfigure   = &workspaceUserBindingResource{}
 	_ resource.ResourceWithImportState = &workspaceUserBindingResource{}
+	_ resource.ResourceWithIdentity    = &workspaceUserBindingResource{}
 )
 
 // NewWorkspaceUserBindingResource is a helper function to simplify the provider implementation.
@@ -115,6 +116,10 @@ func (r *workspaceUserBindingResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *workspaceUserBindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = workspaceBindingIdentitySchema("workspace user binding")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workspaceUserBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan client.MeshWorkspaceUserBinding
@@ -127,15 +132,13 @@ func (r *workspaceUserBindingResource) Create(ctx context.Context, req resource.
 
 	binding, err := r.meshWorkspaceUserBindingClient.Create(ctx, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating workspace user binding",
-			"Could not create workspace user binding, unexpected error: "+err.Error(),
-		)
+		addApiErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating workspace user binding", err)
 		return
 	}
 
 	diags = resp.State.Set(ctx, binding)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newWorkspaceBindingIdentity(binding.MeshWorkspaceBinding))...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -157,6 +160,7 @@ func (r *workspaceUserBindingResource) Read(ctx context.Context, req resource.Re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, binding)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newWorkspaceBindingIdentity(binding.MeshWorkspaceBinding))...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -183,5 +187,16 @@ func (r *workspaceUserBindingResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *workspaceUserBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), req.ID)...)
+	name := importBindingName(ctx, req, &resp.Diagnostics, "workspace user binding", func(name string) (*workspaceBindingIdentity, error) {
+		binding, err := r.meshWorkspaceUserBindingClient.Read(ctx, name)
+		if binding == nil {
+			return nil, err
+		}
+		return new(newWorkspaceBindingIdentity(binding.MeshWorkspaceBinding)), err
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), name)...)
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
 )
 
 // AtMostFor is a factory for Pollable and enables inference of T by compiler from given func return value *T.
@@ -42,7 +44,9 @@ type Pollable[T any] struct {
 }
 
 // Until retries Pollable.Func until the given predicate indicates done.
+// Pollable.Func always reads from meshStack, bypassing the client's read cache.
 func (pollable Pollable[T]) Until(ctx context.Context, until func(item *T) (done bool, err error)) error {
+	ctx = client.WithoutReadCache(ctx)
 	return retry.RetryContext(ctx, pollable.timeout, func() *retry.RetryError {
 		item, err := pollable.f(ctx)
 		if err != nil {
@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+const instrumentationName = "github.com/meshcloud/terraform-provider-meshstack/client"
+
+// Setup enables tracing of the meshStack API requests with OpenTelemetry, configured by the standard
+// OTEL_* environment variables. Tracing is opt-in: it is enabled by OTEL_TRACES_EXPORTER=otlp (or console,
+// writing the spans to stderr), or by setting OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT.
+// The OTLP exporter, sampler and resource are then configured by the SDK from their OTEL_* variables as usual.
+//
+// Spans without parent in their context, which are all of them as Terraform does not propagate its trace
+// context to providers, become children of the span given by the TRACEPARENT environment variable, if set.
+//
+// The returned shutdown flushes the spans not exported yet, it must be called before the process exits.
+func Setup(ctx context.Context, serviceVersion string) (shutdown func(context.Context) error, err error) {
+	shutdown = func(context.Context) error { return nil }
+	exporter, err := newExporter(ctx)
+	if exporter == nil || err != nil {
+		return shutdown, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			attribute.String("service.name", "terraform-provider-meshstack"),
+			attribute.String("service.version", serviceVersion),
+		),
+		// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence.
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return shutdown, fmt.Errorf("cannot create OpenTelemetry resource: %w", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	tracer := NewClientTracer(tracerProvider)
+	tracer.Parent = parentFromEnv(ctx)
+	client.SetTracer(tracer)
+	return tracerProvider.Shutdown, nil
+}
+
+// newExporter returns nil if tracing is disabled.
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	if os.Getenv("OTEL_SDK_DISABLED") == "true" {
+		return nil, nil
+	}
+	tracesExporter := os.Getenv("OTEL_TRACES_EXPORTER")
+	if tracesExporter == "" && cmp.Or(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"), os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")) != "" {
+		tracesExporter = "otlp"
+	}
+	switch tracesExporter {
+	case "", "none":
+		return nil, nil
+	case "console":
+		// stdout belongs to the plugin protocol.
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+	case "otlp":
+		switch protocol := cmp.Or(os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"), os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"), "http/protobuf"); protocol {
+		case "http/protobuf":
+			return otlptracehttp.New(ctx)
+		case "grpc":
+			return otlptracegrpc.New(ctx)
+		default:
+			return nil, fmt.Errorf("unsupported OTLP protocol '%s', must be 'http/protobuf' or 'grpc'", protocol)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported OTEL_TRACES_EXPORTER '%s', must be 'otlp', 'console' or 'none'", tracesExporter)
+	}
+}
+
+func parentFromEnv(ctx context.Context) trace.SpanContext {
+	carrier := propagation.MapCarrier{"traceparent": os.Getenv("TRACEPARENT"), "tracestate": os.Getenv("TRACESTATE")}
+	return trace.SpanContextFromContext(propagation.TraceContext{}.Extract(ctx, carrier))
+}
+
+// ClientTracer implements client.Tracer with OpenTelemetry, propagating the W3C trace context to meshStack.
+type ClientTracer struct {
+	Tracer     trace.Tracer
+	Propagator propagation.TextMapPropagator
+	// Parent of the spans started without span in their context, ignored if invalid.
+	Parent trace.SpanContext
+}
+
+var _ client.Tracer = ClientTracer{}
+
+func NewClientTracer(tracerProvider trace.TracerProvider) ClientTracer {
+	return ClientTracer{
+		Tracer:     tracerProvider.Tracer(instrumentationName),
+		Propagator: propagation.TraceContext{},
+	}
+}
+
+func (t ClientTracer) Start(ctx context.Context, name string) (context.Context, client.Span) {
+	if t.Parent.IsValid() && !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, t.Parent)
+	}
+	ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, clientSpan{span}
+}
+
+func (t ClientTracer) Inject(ctx context.Context, header http.Header) {
+	t.Propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+type clientSpan struct {
+	span trace.Span
+}
+
+func (s clientSpan) SetAttribute(key string, value any) {
+	switch v := value.(type) {
+	case string:
+		s.span.SetAttributes(attribute.String(key, v))
+	case int:
+		s.span.SetAttributes(attribute.Int(key, v))
+	default:
+		s.span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+func (s clientSpan) SetError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s clientSpan) End() {
+	s.span.End()
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/meshcloud/terraform-provider-meshstack/client"
+)
+
+func TestClientTracer(t *testing.T) {
+	t.Setenv("MESHSTACK_SKIP_VERSION_CHECK", "true")
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := NewClientTracer(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	client.SetTracer(tracer)
+	t.Cleanup(func() {
+		client.SetTracer(NewClientTracer(noop.NewTracerProvider()))
+	})
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		traceparent = req.Header.Get("traceparent")
+		resp.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+	rootUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	meshClient, err := client.New(t.Context(), rootUrl, "test-agent", client.NewApiTokenAuthorization("some-token"))
+	require.NoError(t, err)
+	// Ignore the requests negotiating the API versions.
+	exporter.Reset()
+
+	_, err = meshClient.Workspace.Read(t.Context(), "some-workspace")
+	require.Error(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /api/meshobjects/meshworkspaces/{id}", span.Name)
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind)
+	assert.Equal(t, codes.Error, span.Status.Code)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("url.template", "/api/meshobjects/meshworkspaces/{id}"),
+		attribute.String("meshstack.kind", "meshWorkspace"),
+		attribute.String("meshstack.read_cache", "miss"),
+		attribute.Int("http.response.status_code", http.StatusForbidden),
+	}, span.Attributes)
+
+	propagated := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(t.Context(), propagation.MapCarrier{"traceparent": traceparent}))
+	assert.Equal(t, span.SpanContext.TraceID(), propagated.TraceID())
+	assert.Equal(t, span.SpanContext.SpanID(), propagated.SpanID())
+}
+
+func TestParentFromEnv(t *testing.T) {
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	parent := parentFromEnv(t.Context())
+	assert.True(t, parent.IsValid())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", parent.TraceID().String())
+}
@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"github.com/meshcloud/terraform-provider-meshstack/internal/provider"
+	"github.com/meshcloud/terraform-provider-meshstack/internal/util/tracing"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -42,7 +45,17 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	ctx := context.Background()
+	shutdownTracing, err := tracing.Setup(ctx, version)
+	if err != nil {
+		// Tracing is optional, serve the provider anyway. Terraform logs the provider's stderr.
+		_, _ = fmt.Fprintf(os.Stderr, "tracing disabled: %s\n", err)
+	}
+	defer func() {
+		_ = shutdownTracing(ctx)
+	}()
+
+	err = providerserver.Serve(ctx, provider.New(version), opts)
 
 	if err != nil {
 		panic(err)